@@ -0,0 +1,356 @@
+package hwapi
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Device classes set by deviceBasedDynamicContent
+const (
+	DeviceMobile  = "mobile"
+	DeviceDesktop = "desktop"
+)
+
+// CacheKey edge cache key of request
+type CacheKey struct {
+	// Key host/path?query followed by |name:value of every header variant
+	Key  string
+	Host string
+	// Path after cacheKeyModification
+	Path string
+	// Query parameters included in key by dynamicContent
+	Query url.Values
+	// Headers "name:value" included in key by dynamicContent, name is lower case, sorted
+	Headers []string
+	// Device class written by deviceBasedDynamicContent, empty if policy doesn't apply
+	Device string
+	// Policies which changed the key, such like dynamicContent[0]
+	Policies []string
+	// Warnings settings which fragment cache or have no effect
+	Warnings []string
+}
+
+func (k *CacheKey) warnf(format string, args ...interface{}) {
+	k.Warnings = append(k.Warnings, fmt.Sprintf(format, args...))
+}
+
+// queryParamRule queryParams of dynamicContent, comma separated names, "*" includes all, it's not a glob
+type queryParamRule struct {
+	all     bool
+	include map[string]bool
+}
+
+func parseQueryParams(s string) *queryParamRule {
+	r := &queryParamRule{include: map[string]bool{}}
+	for _, n := range strings.Split(s, ",") {
+		switch n = strings.TrimSpace(n); n {
+		case "":
+		case "*":
+			r.all = true
+		default:
+			r.include[n] = true
+		}
+	}
+	return r
+}
+
+func (r *queryParamRule) includes(name string) bool {
+	return r.all || r.include[name]
+}
+
+// headerFieldPattern single element of headerFields, value is nil for name only pattern
+type headerFieldPattern struct {
+	name  *regexp.Regexp
+	value *regexp.Regexp
+}
+
+func parseHeaderFields(s string) ([]*headerFieldPattern, error) {
+	res := []*headerFieldPattern{}
+	for _, f := range strings.Split(s, ",") {
+		if f = strings.TrimSpace(f); f == "" {
+			continue
+		}
+		p := &headerFieldPattern{}
+		name, value, hasValue := f, "", false
+		if i := strings.Index(f, ":"); i >= 0 {
+			name, value, hasValue = strings.TrimSpace(f[:i]), strings.TrimSpace(f[i+1:]), true
+		}
+		var e error
+		if p.name, e = regexp.Compile("(?i)" + wildcardToRegexp(name, true)); e != nil {
+			return nil, fmt.Errorf("invalid header field %q, %s", f, e.Error())
+		}
+		if hasValue {
+			if p.value, e = regexp.Compile(wildcardToRegexp(value, true)); e != nil {
+				return nil, fmt.Errorf("invalid header field %q, %s", f, e.Error())
+			}
+		}
+		res = append(res, p)
+	}
+	return res, nil
+}
+
+func (p *headerFieldPattern) match(name string, value string) bool {
+	return p.name.MatchString(name) && (p.value == nil || p.value.MatchString(value))
+}
+
+// CacheKey compute edge cache key of request under configuration, usually an EffectiveConfiguration
+//
+// Query string is not part of the key unless a matched dynamicContent lists the parameter in queryParams,
+// "*" includes all parameters, other names are matched exactly. Request headers matched by headerFields are added
+// as variants. Every matched dynamicContent contributes to the key.
+//
+// deviceBasedDynamicContent rewrites the device parameter and header (nameOverride) of request to
+// mobile or desktop by mobileDevicePattern on User-Agent, they only vary the key if dynamicContent includes them.
+//
+// cacheKeyModification normalizeKeyPathToLowerCase lower cases path, query string is kept as is.
+func (c *Configuration) CacheKey(req *PolicyRequest) (*CacheKey, error) {
+	k := &CacheKey{Host: strings.ToLower(req.URL.Host), Path: req.URL.EscapedPath(), Query: url.Values{}, Headers: []string{}, Policies: []string{}, Warnings: []string{}}
+	if k.Path == "" {
+		k.Path = "/"
+	}
+	query := req.URL.Query()
+	header := req.Header.Clone()
+	if header == nil {
+		header = http.Header{}
+	}
+
+	if p := c.DeviceBasedDynamicContent; p != nil && PolicyApplies(p, req) {
+		re, e := regexp.Compile(p.MobileDevicePattern)
+		if e != nil {
+			return nil, fmt.Errorf("deviceBasedDynamicContent: %w", e)
+		}
+		k.Device = DeviceDesktop
+		if p.MobileDevicePattern != "" && re.MatchString(req.Header.Get("User-Agent")) {
+			k.Device = DeviceMobile
+		}
+		name := p.NameOverride
+		if name == "" {
+			name = "device"
+		}
+		query.Set(name, k.Device)
+		header.Set(name, k.Device)
+	}
+
+	deviceUsed := false
+	for i, p := range c.DynamicContent {
+		if p == nil || !PolicyApplies(p, req) {
+			continue
+		}
+		used := false
+		rule := parseQueryParams(p.QueryParams)
+		for name, values := range query {
+			if rule.includes(name) {
+				k.Query[name] = values
+				used = true
+			}
+		}
+		fields, e := parseHeaderFields(p.HeaderFields)
+		if e != nil {
+			return nil, fmt.Errorf("dynamicContent[%d]: %w", i, e)
+		}
+		for name, values := range header {
+			for _, v := range values {
+				for _, f := range fields {
+					if f.match(name, v) {
+						k.Headers = append(k.Headers, strings.ToLower(name)+":"+v)
+						used = true
+						break
+					}
+				}
+			}
+		}
+		if used {
+			k.Policies = append(k.Policies, fmt.Sprintf("dynamicContent[%d]", i))
+		}
+	}
+	if k.Device != "" {
+		name := c.DeviceBasedDynamicContent.NameOverride
+		if name == "" {
+			name = "device"
+		}
+		_, deviceUsed = k.Query[name]
+		for _, h := range k.Headers {
+			deviceUsed = deviceUsed || strings.HasPrefix(h, strings.ToLower(name)+":")
+		}
+		if deviceUsed {
+			k.Policies = append(k.Policies, "deviceBasedDynamicContent")
+		} else {
+			k.warnf("deviceBasedDynamicContent: no dynamicContent includes %s, device class doesn't vary cache key", name)
+		}
+	}
+	k.Headers = uniqueSorted(k.Headers)
+
+	if p := c.CacheKeyModification; p != nil && boolValue(p.NormalizeKeyPathToLowerCase) && policyEnabledValue(reflect.ValueOf(p).Elem(), true) {
+		k.Path = strings.ToLower(k.Path)
+		k.Policies = append(k.Policies, "cacheKeyModification")
+	} else if k.Path != strings.ToLower(k.Path) {
+		k.warnf("cacheKeyModification: path has upper case letters, case variants are cached separately")
+	}
+
+	if p := c.OriginPull; p != nil && boolValue(p.NoQSParams) && len(k.Query) > 0 && policyEnabledValue(reflect.ValueOf(p).Elem(), true) {
+		k.warnf("originPull: noQSParams removes query string from origin request, variants of %v are identical", sortedQueryNames(k.Query))
+	}
+
+	b := &strings.Builder{}
+	b.WriteString(k.Host)
+	b.WriteString(k.Path)
+	if len(k.Query) > 0 {
+		b.WriteString("?")
+		b.WriteString(k.Query.Encode())
+	}
+	for _, h := range k.Headers {
+		b.WriteString("|")
+		b.WriteString(h)
+	}
+	k.Key = b.String()
+	return k, nil
+}
+
+func uniqueSorted(l []string) []string {
+	sort.Strings(l)
+	res := l[:0]
+	for i, s := range l {
+		if i == 0 || s != l[i-1] {
+			res = append(res, s)
+		}
+	}
+	return res
+}
+
+func sortedQueryNames(q url.Values) []string {
+	res := []string{}
+	for k := range q {
+		res = append(res, k)
+	}
+	sort.Strings(res)
+	return res
+}
+
+// CacheKeyPathStats cache keys of a lower cased path
+type CacheKeyPathStats struct {
+	// Path host/path in lower case, case variants are counted together
+	Path     string
+	Requests int
+	// Keys distinct cache keys
+	Keys int
+	// Params number of distinct values of every key query parameter and header
+	Params map[string]int
+
+	keys   map[string]bool
+	values map[string]map[string]bool
+}
+
+// CacheKeyCardinality cache keys of access log sample
+type CacheKeyCardinality struct {
+	Requests int
+	Keys     int
+	// Skipped lines without URL
+	Skipped int
+	// Paths sorted by Keys descending, most fragmented first
+	Paths []*CacheKeyPathStats
+	// Warnings distinct warnings of all requests
+	Warnings []string
+}
+
+// logURL first field of access log line which looks like URL or path
+func logURL(line string) string {
+	for _, f := range strings.Fields(line) {
+		f = strings.Trim(f, `"`)
+		if strings.HasPrefix(f, "/") || strings.HasPrefix(f, "http://") || strings.HasPrefix(f, "https://") {
+			return f
+		}
+	}
+	return ""
+}
+
+// CacheKeyCardinality read access log URLs from r, count distinct cache keys of every path
+// Each line is an URL or access log line which contains one, lines starting with # are comments.
+// Paths without host are requested on host. Requests are sent without headers except device detection
+func (c *Configuration) CacheKeyCardinality(host string, r io.Reader) (*CacheKeyCardinality, error) {
+	res := &CacheKeyCardinality{Paths: []*CacheKeyPathStats{}, Warnings: []string{}}
+	stats := map[string]*CacheKeyPathStats{}
+	keys := map[string]bool{}
+	warnings := map[string]bool{}
+	s := bufio.NewScanner(r)
+	s.Buffer(make([]byte, 64*1024), 1024*1024)
+	for s.Scan() {
+		line := strings.TrimSpace(s.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		raw := logURL(line)
+		if raw == "" {
+			res.Skipped++
+			continue
+		}
+		req, e := NewPolicyRequest(GET, raw, nil, "")
+		if e != nil {
+			res.Skipped++
+			continue
+		}
+		if req.URL.Host == "" {
+			req.URL.Host = host
+		}
+		k, e := c.CacheKey(req)
+		if e != nil {
+			return nil, e
+		}
+		res.Requests++
+		keys[k.Key] = true
+		for _, w := range k.Warnings {
+			warnings[w] = true
+		}
+		p := strings.ToLower(k.Host + k.Path)
+		st, ok := stats[p]
+		if !ok {
+			st = &CacheKeyPathStats{Path: p, Params: map[string]int{}, keys: map[string]bool{}, values: map[string]map[string]bool{}}
+			stats[p] = st
+		}
+		st.Requests++
+		st.keys[k.Key] = true
+		for name, vs := range k.Query {
+			st.addValue(name, strings.Join(vs, ","))
+		}
+		for _, h := range k.Headers {
+			i := strings.Index(h, ":")
+			st.addValue(h[:i]+":", h[i+1:])
+		}
+	}
+	if e := s.Err(); e != nil {
+		return nil, e
+	}
+	res.Keys = len(keys)
+	for _, st := range stats {
+		st.Keys = len(st.keys)
+		for name, vs := range st.values {
+			st.Params[name] = len(vs)
+		}
+		res.Paths = append(res.Paths, st)
+	}
+	sort.Slice(res.Paths, func(i, j int) bool {
+		if res.Paths[i].Keys != res.Paths[j].Keys {
+			return res.Paths[i].Keys > res.Paths[j].Keys
+		}
+		return res.Paths[i].Path < res.Paths[j].Path
+	})
+	for w := range warnings {
+		res.Warnings = append(res.Warnings, w)
+	}
+	sort.Strings(res.Warnings)
+	return res, nil
+}
+
+// addValue record value of query parameter or header, header names end with colon
+func (st *CacheKeyPathStats) addValue(name string, value string) {
+	if st.values[name] == nil {
+		st.values[name] = map[string]bool{}
+	}
+	st.values[name][value] = true
+}
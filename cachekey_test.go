@@ -0,0 +1,81 @@
+package hwapi_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/bucloud/hwapi"
+)
+
+func TestCacheKey(t *testing.T) {
+	c := &hwapi.Configuration{}
+	if e := json.Unmarshal([]byte(`{
+		"dynamicContent":[
+			{"pathFilter":"/img/*","queryParams":"w,h"},
+			{"pathFilter":"/api/*","queryParams":"*","headerFields":"accept-language,x-tier:gold"},
+			{"pathFilter":"/m/*","queryParams":"device"}
+		],
+		"deviceBasedDynamicContent":{"mobileDevicePattern":"(?i)iphone|android","pathFilter":"/m/*"},
+		"cacheKeyModification":{"normalizeKeyPathToLowerCase":true},
+		"originPull":{"noQSParams":true}
+	}`), c); e != nil {
+		t.Fatal(e)
+	}
+	cases := []struct {
+		url    string
+		header http.Header
+		key    string
+	}{
+		{"http://Www.Example.com/img/A.png?h=2&w=1&x=3", nil, "www.example.com/img/a.png?h=2&w=1"},
+		{"http://www.example.com/css/a.css?v=1", nil, "www.example.com/css/a.css"},
+		{"http://www.example.com/img/a.png?w*=1&W=2", nil, "www.example.com/img/a.png"},
+		{"http://www.example.com/api/q?ts=1&q=a", http.Header{"Accept-Language": {"fr"}, "X-Tier": {"silver"}}, "www.example.com/api/q?q=a&ts=1|accept-language:fr"},
+		{"http://www.example.com/api/q", http.Header{"X-Tier": {"gold"}}, "www.example.com/api/q|x-tier:gold"},
+		{"http://www.example.com/m/a?device=tv", http.Header{"User-Agent": {"Mozilla/5.0 (iPhone)"}}, "www.example.com/m/a?device=mobile"},
+		{"http://www.example.com/m/a", nil, "www.example.com/m/a?device=desktop"},
+	}
+	for _, tc := range cases {
+		req, _ := hwapi.NewPolicyRequest("GET", tc.url, tc.header, "")
+		k, e := c.CacheKey(req)
+		if e != nil {
+			t.Fatal(e)
+		}
+		if k.Key != tc.key {
+			t.Errorf("%s: got %s, want %s %v", tc.url, k.Key, tc.key, k.Warnings)
+		}
+	}
+
+	req, _ := hwapi.NewPolicyRequest("GET", "http://www.example.com/img/a.png?w=1", nil, "")
+	k, _ := c.CacheKey(req)
+	if len(k.Warnings) != 1 || !strings.Contains(k.Warnings[0], "noQSParams") {
+		t.Errorf("expect noQSParams warning, got %v", k.Warnings)
+	}
+}
+
+func TestCacheKeyCardinality(t *testing.T) {
+	c := &hwapi.Configuration{DynamicContent: []*hwapi.DynamicContent{{QueryParams: "w"}}}
+	logs := strings.Join([]string{
+		"#Fields: date time cs-uri",
+		"2020-01-01 00:00:00 /a.png?w=1",
+		"2020-01-01 00:00:00 /A.png?w=2&x=1",
+		"2020-01-01 00:00:00 /a.png?w=1&x=2",
+		"http://other.example.com/b.png",
+		"broken line",
+	}, "\n")
+	r, e := c.CacheKeyCardinality("www.example.com", strings.NewReader(logs))
+	if e != nil {
+		t.Fatal(e)
+	}
+	if r.Requests != 4 || r.Keys != 3 || r.Skipped != 1 || len(r.Paths) != 2 {
+		t.Fatalf("unexpected %+v", r)
+	}
+	p := r.Paths[0]
+	if p.Path != "www.example.com/a.png" || p.Keys != 2 || p.Requests != 3 || p.Params["w"] != 2 {
+		t.Errorf("unexpected %+v", p)
+	}
+	if len(r.Warnings) != 1 {
+		t.Errorf("expect case warning, got %v", r.Warnings)
+	}
+}
@@ -0,0 +1,360 @@
+package hwapi
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Origin pull expire policies
+const (
+	ExpireCacheControl = "CACHE_CONTROL"
+	ExpireIngest       = "INGEST"
+	ExpireLastModify   = "LAST_MODIFY"
+	ExpireNever        = "NEVER_EXPIRE"
+	ExpireDoNotCache   = "DO_NOT_CACHE"
+)
+
+// CacheRequest request and origin response evaluated by SimulateCache
+type CacheRequest struct {
+	*PolicyRequest
+	// Status response code of origin, default 200
+	Status int
+	// ResponseHeader headers of origin response, such like Cache-Control, Expires, Date
+	ResponseHeader http.Header
+	// Now used to evaluate Expires if origin doesn't send Date, default time.Now()
+	Now time.Time
+	// NegativeTTL TTL of negative responses not matched by any policy, originPullNegLinger of the host
+	NegativeTTL time.Duration
+}
+
+// CacheResult how edge caches the response and what it sends to client
+type CacheResult struct {
+	// Status response code after dynamicCacheRule
+	Status int
+	// Cacheable false means response is proxied without being stored
+	Cacheable bool
+	// TTL edge TTL, 0 with Cacheable means stored but always revalidated
+	TTL time.Duration
+	// NeverExpire stored until evicted
+	NeverExpire bool
+	// Revalidate edge checks origin with conditional request after TTL
+	Revalidate bool
+	// CacheControl header sent to client, empty means none
+	CacheControl string
+	// Header added by dynamicCacheRule
+	Header http.Header
+	// Policy decided TTL, such like originPullPolicy[1], empty if origin headers are used directly
+	Policy string
+	// ClientPolicy decided CacheControl
+	ClientPolicy string
+	// Trace explain how policies were evaluated
+	Trace []string
+}
+
+func (r *CacheResult) tracef(format string, args ...interface{}) {
+	r.Trace = append(r.Trace, fmt.Sprintf(format, args...))
+}
+
+// cacheDirectives parsed Cache-Control, directive => value
+type cacheDirectives map[string]string
+
+func parseCacheControl(h http.Header) cacheDirectives {
+	d := cacheDirectives{}
+	for _, v := range h.Values("Cache-Control") {
+		for _, part := range strings.Split(v, ",") {
+			kv := strings.SplitN(strings.TrimSpace(part), "=", 2)
+			if kv[0] == "" {
+				continue
+			}
+			val := ""
+			if len(kv) == 2 {
+				val = strings.Trim(strings.TrimSpace(kv[1]), `"`)
+			}
+			d[strings.ToLower(kv[0])] = val
+		}
+	}
+	return d
+}
+
+func (d cacheDirectives) has(name string) bool {
+	_, ok := d[name]
+	return ok
+}
+
+// seconds value of directive, -1 if missing or invalid
+func (d cacheDirectives) seconds(name string) int64 {
+	v, ok := d[name]
+	if !ok {
+		return -1
+	}
+	n, e := strconv.ParseInt(v, 10, 64)
+	if e != nil || n < 0 {
+		return -1
+	}
+	return n
+}
+
+func (d cacheDirectives) String() string {
+	keys := []string{}
+	for k := range d {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return strings.Join(keys, ",")
+}
+
+// isPositiveStatus statuses originPullPolicy applies to if statusCodeMatch is empty,
+// "all positive response codes (2xx, 301, 302 and 307)" as statusCodeMatch of originPullPolicy documented
+func isPositiveStatus(status int) bool {
+	return (status >= 200 && status < 300) || status == 301 || status == 302 || status == 307
+}
+
+// statusCodeMatches evaluate statusCodeMatch glob list, empty matches def
+func statusCodeMatches(expr string, status int, def bool) (bool, error) {
+	if strings.TrimSpace(expr) == "" {
+		return def, nil
+	}
+	f, e := ParseFilter(expr)
+	if e != nil {
+		return false, e
+	}
+	return f.Match(strconv.Itoa(status)), nil
+}
+
+func boolValue(b *bool) bool {
+	return b != nil && *b
+}
+
+// SimulateCache evaluate dynamicCacheRule, originPull, originPullPolicy and cacheControl of configuration,
+// usually an EffectiveConfiguration, against request and origin response.
+//
+// dynamicCacheRule: first rule matching request replaces status and adds headers.
+//
+// originPullPolicy: first policy matching request filters and statusCodeMatch decides TTL, by expirePolicy,
+// for CACHE_CONTROL the honor* flags decide which origin directives are respected, TTL comes from
+// s-maxage (honorSMaxAge), max-age, Expires, then expireSeconds, where 0 means until evicted.
+// Negative responses without matched policy use NegativeTTL.
+//
+// cacheControl: first policy matching statusCodeMatch decides client Cache-Control, override first,
+// then maxAge if set, 0 included, then edge TTL if synchronizeMaxAge, otherwise origin header is passed through.
+func (c *Configuration) SimulateCache(req *CacheRequest) (*CacheResult, error) {
+	status := req.Status
+	if status == 0 {
+		status = http.StatusOK
+	}
+	header := req.ResponseHeader
+	if header == nil {
+		header = http.Header{}
+	}
+	res := &CacheResult{Status: status, Header: http.Header{}, Trace: []string{}}
+
+	for i, p := range c.DynamicCacheRule {
+		if p == nil || !PolicyApplies(p, req.PolicyRequest) {
+			continue
+		}
+		res.Status = int(p.StatusCode)
+		for _, h := range splitFilterList(p.Headers) {
+			if kv := strings.SplitN(h, ":", 2); len(kv) == 2 {
+				res.Header.Add(strings.TrimSpace(kv[0]), strings.TrimSpace(kv[1]))
+			}
+		}
+		res.tracef("dynamicCacheRule[%d]: status %d", i, res.Status)
+		break
+	}
+
+	cc := parseCacheControl(header)
+	if c.OriginPull != nil && strings.EqualFold(c.OriginPull.DefaultBehavior, "nostore") {
+		res.Policy = "originPull"
+		res.tracef("originPull: defaultBehavior nostore, nothing is cached")
+	} else if e := c.simulateEdgeTTL(req, res, header, cc); e != nil {
+		return nil, e
+	}
+
+	if e := c.simulateClientCacheControl(res, header); e != nil {
+		return nil, e
+	}
+	return res, nil
+}
+
+func (c *Configuration) simulateEdgeTTL(req *CacheRequest, res *CacheResult, header http.Header, cc cacheDirectives) error {
+	var policy *OriginPullPolicy
+	for i, p := range c.OriginPullPolicy {
+		if p == nil {
+			continue
+		}
+		if m, filters := ExplainPolicy(p, req.PolicyRequest); !m {
+			res.tracef("originPullPolicy[%d]: disabled or filters don't match %s", i, filterResultsString(filters))
+			continue
+		}
+		m, e := statusCodeMatches(p.StatusCodeMatch, res.Status, isPositiveStatus(res.Status))
+		if e != nil {
+			return fmt.Errorf("originPullPolicy[%d]: statusCodeMatch %w", i, e)
+		}
+		if !m {
+			res.tracef("originPullPolicy[%d]: statusCodeMatch %q doesn't match %d", i, p.StatusCodeMatch, res.Status)
+			continue
+		}
+		policy, res.Policy = p, fmt.Sprintf("originPullPolicy[%d]", i)
+		break
+	}
+
+	if policy == nil {
+		if !isPositiveStatus(res.Status) {
+			res.Cacheable, res.TTL = req.NegativeTTL > 0, req.NegativeTTL
+			res.tracef("no originPullPolicy for negative response, negative TTL %s", req.NegativeTTL)
+			return nil
+		}
+		policy = &OriginPullPolicy{ExpirePolicy: ExpireCacheControl}
+		res.tracef("no originPullPolicy matched, origin Cache-Control is used")
+	}
+
+	switch strings.ToUpper(policy.ExpirePolicy) {
+	case ExpireDoNotCache:
+		res.tracef("%s: expirePolicy DO_NOT_CACHE", res.Policy)
+		return nil
+	case ExpireNever:
+		res.Cacheable, res.NeverExpire = true, true
+		res.tracef("%s: expirePolicy NEVER_EXPIRE", res.Policy)
+		return nil
+	case ExpireIngest:
+		res.Cacheable, res.TTL = true, time.Duration(policy.ExpireSeconds)*time.Second
+		res.tracef("%s: expirePolicy INGEST, %ds after ingest", res.Policy, policy.ExpireSeconds)
+		return nil
+	case ExpireLastModify:
+		res.Cacheable, res.Revalidate, res.TTL = true, true, time.Duration(policy.ExpireSeconds)*time.Second
+		res.tracef("%s: expirePolicy LAST_MODIFY, revalidate every %ds", res.Policy, policy.ExpireSeconds)
+		return nil
+	}
+
+	label := res.Policy
+	if label == "" {
+		label = "origin"
+	}
+	res.tracef("%s: expirePolicy CACHE_CONTROL, origin directives [%s]", label, cc)
+	switch {
+	case boolValue(policy.HonorNoStore) && cc.has("no-store"):
+		res.tracef("no-store honored, not cached")
+		return nil
+	case boolValue(policy.HonorPrivate) && cc.has("private"):
+		res.tracef("private honored, not cached")
+		return nil
+	case policy.BypassCacheIdentifier != "" && strings.Contains(strings.Join(header.Values("Cache-Control"), ","), policy.BypassCacheIdentifier):
+		res.tracef("bypassCacheIdentifier %q found, not cached", policy.BypassCacheIdentifier)
+		return nil
+	}
+
+	noCache := ""
+	switch {
+	case boolValue(policy.HonorNoCache) && cc.has("no-cache"):
+		noCache = "no-cache honored"
+	case boolValue(policy.MustRevalidateToNoCache) && cc.has("must-revalidate"):
+		noCache = "must-revalidate treated as no-cache"
+	case boolValue(policy.MaxAgeZeroToNoCache) && cc.seconds("max-age") == 0:
+		noCache = "max-age=0 treated as no-cache"
+	}
+	if noCache != "" {
+		switch {
+		case boolValue(policy.ForceBypassCache):
+			res.tracef("%s, forceBypassCache, not cached", noCache)
+		case strings.EqualFold(policy.NoCacheBehavior, "spec"):
+			res.tracef("%s, noCacheBehavior spec, proxied to origin", noCache)
+		default:
+			res.Cacheable, res.Revalidate = true, true
+			res.tracef("%s, noCacheBehavior legacy, cached as always expired", noCache)
+		}
+		return nil
+	}
+
+	res.Cacheable = true
+	if boolValue(policy.HonorMustRevalidate) && (cc.has("must-revalidate") || cc.has("proxy-revalidate")) {
+		res.Revalidate = true
+	}
+	if s := cc.seconds("s-maxage"); s >= 0 && boolValue(policy.HonorSMaxAge) {
+		res.TTL = time.Duration(s) * time.Second
+		res.tracef("TTL from s-maxage")
+		return nil
+	}
+	if s := cc.seconds("max-age"); s >= 0 {
+		res.TTL = time.Duration(s) * time.Second
+		res.tracef("TTL from max-age")
+		return nil
+	}
+	if exp := header.Get("Expires"); exp != "" {
+		now := req.Now
+		if d, e := http.ParseTime(header.Get("Date")); e == nil {
+			now = d
+		} else if now.IsZero() {
+			now = time.Now()
+		}
+		t, e := http.ParseTime(exp)
+		if e != nil || t.Before(now) {
+			res.tracef("Expires %q is invalid or in the past, TTL 0", exp)
+			return nil
+		}
+		res.TTL = t.Sub(now)
+		res.tracef("TTL from Expires")
+		return nil
+	}
+	if policy.ExpireSeconds == 0 {
+		res.NeverExpire = true
+		res.tracef("no origin expiration and expireSeconds 0, cached until evicted")
+		return nil
+	}
+	res.TTL = time.Duration(policy.ExpireSeconds) * time.Second
+	res.tracef("no origin expiration, TTL from expireSeconds")
+	return nil
+}
+
+func (c *Configuration) simulateClientCacheControl(res *CacheResult, header http.Header) error {
+	origin := strings.Join(header.Values("Cache-Control"), ", ")
+	for i, p := range c.CacheControl {
+		if p == nil || !policyEnabledValue(reflect.ValueOf(p).Elem(), true) {
+			continue
+		}
+		m, e := statusCodeMatches(p.StatusCodeMatch, res.Status, true)
+		if e != nil {
+			return fmt.Errorf("cacheControl[%d]: statusCodeMatch %w", i, e)
+		}
+		if !m {
+			res.tracef("cacheControl[%d]: statusCodeMatch %q doesn't match %d", i, p.StatusCodeMatch, res.Status)
+			continue
+		}
+		res.ClientPolicy = fmt.Sprintf("cacheControl[%d]", i)
+		switch {
+		case p.Override != "":
+			res.CacheControl = p.Override
+			res.tracef("%s: override", res.ClientPolicy)
+			return nil
+		case p.MaxAge > 0 || (p.MaxAge == 0 && p.has("maxAge")):
+			res.CacheControl = fmt.Sprintf("max-age=%d", p.MaxAge)
+			res.tracef("%s: maxAge", res.ClientPolicy)
+		case p.SynchronizeMaxAge == nil || *p.SynchronizeMaxAge:
+			if !res.Cacheable {
+				res.CacheControl = origin
+				res.tracef("%s: synchronizeMaxAge, response not cached, origin header passed", res.ClientPolicy)
+				return nil
+			}
+			ttl := int64(res.TTL / time.Second)
+			if res.NeverExpire {
+				ttl = 31536000
+			}
+			res.CacheControl = fmt.Sprintf("max-age=%d", ttl)
+			res.tracef("%s: synchronizeMaxAge with edge TTL", res.ClientPolicy)
+		default:
+			res.CacheControl = origin
+			res.tracef("%s: origin header passed", res.ClientPolicy)
+			return nil
+		}
+		if boolValue(p.MustRevalidate) {
+			res.CacheControl += ", must-revalidate"
+		}
+		return nil
+	}
+	res.CacheControl = origin
+	return nil
+}
@@ -0,0 +1,98 @@
+package hwapi_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/bucloud/hwapi"
+)
+
+func TestSimulateCache(t *testing.T) {
+	c := &hwapi.Configuration{}
+	if e := json.Unmarshal([]byte(`{
+		"dynamicCacheRule":[{"statusCode":404,"pathFilter":"/gone/*","headers":"X-Gone: 1"}],
+		"originPullPolicy":[
+			{"expirePolicy":"DO_NOT_CACHE","pathFilter":"/api/*"},
+			{"expirePolicy":"INGEST","expireSeconds":60,"statusCodeMatch":"404"},
+			{"expirePolicy":"CACHE_CONTROL","expireSeconds":300,"honorSMaxAge":true,"honorNoCache":true,"maxAgeZeroToNoCache":true,"honorNoStore":true}
+		],
+		"cacheControl":[{"statusCodeMatch":"404","maxAge":10},{"synchronizeMaxAge":true}]
+	}`), c); e != nil {
+		t.Fatal(e)
+	}
+	cases := []struct {
+		path      string
+		status    int
+		cc        string
+		cacheable bool
+		ttl       time.Duration
+		policy    string
+		client    string
+	}{
+		{"/api/x", 200, "max-age=600", false, 0, "originPullPolicy[0]", "max-age=600"},
+		{"/a.js", 200, "max-age=600, s-maxage=60", true, time.Minute, "originPullPolicy[2]", "max-age=60"},
+		{"/a.js", 200, "max-age=600", true, 10 * time.Minute, "originPullPolicy[2]", "max-age=600"},
+		{"/a.js", 200, "", true, 5 * time.Minute, "originPullPolicy[2]", "max-age=300"},
+		{"/a.js", 200, "max-age=0", true, 0, "originPullPolicy[2]", "max-age=0"},
+		{"/a.js", 200, "no-store", false, 0, "originPullPolicy[2]", "no-store"},
+		{"/gone/a", 200, "max-age=600", true, time.Minute, "originPullPolicy[1]", "max-age=10"},
+		{"/a.js", 503, "", false, 0, "", ""},
+	}
+	for _, tc := range cases {
+		pr, _ := hwapi.NewPolicyRequest("GET", "http://www.example.com"+tc.path, nil, "")
+		h := http.Header{}
+		if tc.cc != "" {
+			h.Set("Cache-Control", tc.cc)
+		}
+		r, e := c.SimulateCache(&hwapi.CacheRequest{PolicyRequest: pr, Status: tc.status, ResponseHeader: h})
+		if e != nil {
+			t.Fatal(e)
+		}
+		if r.Cacheable != tc.cacheable || r.TTL != tc.ttl || r.Policy != tc.policy || r.CacheControl != tc.client {
+			t.Errorf("%s %d %q: got %t %s %q %q, want %t %s %q %q\n%v", tc.path, tc.status, tc.cc, r.Cacheable, r.TTL, r.Policy, r.CacheControl, tc.cacheable, tc.ttl, tc.policy, tc.client, r.Trace)
+		}
+	}
+}
+
+func TestSimulateCacheExpires(t *testing.T) {
+	c := &hwapi.Configuration{OriginPullPolicy: []*hwapi.OriginPullPolicy{{ExpirePolicy: hwapi.ExpireCacheControl}}}
+	pr, _ := hwapi.NewPolicyRequest("GET", "http://www.example.com/a", nil, "")
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	h := http.Header{"Expires": {now.Add(time.Hour).Format(http.TimeFormat)}}
+	r, e := c.SimulateCache(&hwapi.CacheRequest{PolicyRequest: pr, ResponseHeader: h, Now: now})
+	if e != nil || r.TTL != time.Hour || r.CacheControl != "" {
+		t.Errorf("got %v %v", r, e)
+	}
+}
+
+func TestSimulateCacheMaxAge(t *testing.T) {
+	pr, _ := hwapi.NewPolicyRequest("GET", "http://www.example.com/a", nil, "")
+	h := http.Header{"Cache-Control": {"max-age=600"}}
+	for _, tc := range []struct {
+		conf   string
+		client string
+	}{
+		{`{"cacheControl":[{"maxAge":0}]}`, "max-age=0"},
+		{`{"cacheControl":[{"maxAge":30,"mustRevalidate":true}]}`, "max-age=30, must-revalidate"},
+		{`{"cacheControl":[{"maxAge":-1}]}`, "max-age=600"},
+		{`{"cacheControl":[{}]}`, "max-age=600"},
+		{`{"cacheControl":[{"synchronizeMaxAge":false}]}`, "max-age=600"},
+	} {
+		c := &hwapi.Configuration{}
+		if e := json.Unmarshal([]byte(tc.conf), c); e != nil {
+			t.Fatal(e)
+		}
+		r, e := c.SimulateCache(&hwapi.CacheRequest{PolicyRequest: pr, ResponseHeader: h})
+		if e != nil || r.CacheControl != tc.client {
+			t.Errorf("%s: got %q %v, want %q", tc.conf, r.CacheControl, e, tc.client)
+		}
+	}
+
+	// maxAge not set in code isn't max-age=0
+	c := &hwapi.Configuration{CacheControl: []*hwapi.CacheControl{{}}}
+	if r, e := c.SimulateCache(&hwapi.CacheRequest{PolicyRequest: pr, ResponseHeader: h}); e != nil || r.CacheControl != "max-age=600" {
+		t.Errorf("got %v %v", r, e)
+	}
+}
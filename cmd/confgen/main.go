@@ -0,0 +1,323 @@
+// confgen generate configuration_types.go from a saved copy of configuration doc
+//
+// Usage
+//
+//	go run ./cmd/confgen -doc schema/configuration.json -out configuration_types.go
+//
+// The doc is a JSON object with a "list" of configuration types, each type contains
+// name, description, allowedScope, groupable, defaultPolicy and fields.
+// Every field contains name, type(boolean,string,uint16,uint32,int32), description,
+// required, default, role, writeOnly and optional list/enum/range/advancedType/hashMap,
+// these values are copied to struct tags as is.
+// Fields holding credentials are tagged sensitive:"true", see sensitiveFields.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Doc configuration doc
+type Doc struct {
+	List []*Type `json:"list"`
+}
+
+// Type configuration type
+type Type struct {
+	Name          string          `json:"name"`
+	Description   string          `json:"description"`
+	AllowedScope  string          `json:"allowedScope"`
+	Groupable     bool            `json:"groupable"`
+	DefaultPolicy json.RawMessage `json:"defaultPolicy"`
+	Fields        []*Field        `json:"fields"`
+}
+
+// Field configuration field
+type Field struct {
+	Name         string `json:"name"`
+	Type         string `json:"type"`
+	Description  string `json:"description"`
+	Required     bool   `json:"required"`
+	Default      string `json:"default"`
+	Role         string `json:"role"`
+	WriteOnly    string `json:"writeOnly"`
+	List         string `json:"list,omitempty"`
+	Enum         string `json:"enum,omitempty"`
+	Range        string `json:"range,omitempty"`
+	AdvancedType string `json:"advancedType,omitempty"`
+	HashMap      string `json:"hashMap,omitempty"`
+}
+
+// handWritten types not listed in configuration doc but still part of Configuration
+// key is the doc type after which the field should been placed
+var handWritten = map[string][2]string{
+	"originPullShield": {"originPullHost", "OriginPullHost"},
+}
+
+// typeNames keep already published type names which don't follow the naming rules
+var typeNames = map[string]string{
+	"authSignURLsInPlaylist": "AuthSignUrlsInPlaylist",
+	"dnsIpv6":                "DNSIPv6",
+}
+
+// fieldNames keep already published Configuration field names which don't follow the naming rules
+var fieldNames = map[string]string{
+	"authSignURLsInPlaylist": "AuthSignUrlsInPlaylist",
+}
+
+// sensitiveFields fields holding credentials, doc type => field names
+// fields with advancedType PASSWORD are sensitive as well, the doc only marks a few of them
+var sensitiveFields = map[string][]string{
+	"authUrlSign":          {"passPhrase"},
+	"authUrlSignAliCloudA": {"passPhrase"},
+	"authUrlSignAliCloudB": {"passPhrase"},
+	"authUrlSignAliCloudC": {"passPhrase"},
+	"authUrlSignAKv1":      {"salt"},
+	"authUrlSignAKv2":      {"passPhrase", "salt"},
+	"authUrlSignHmacTlu":   {"symmetricKeyIdMap"},
+	"authUrlSignIq":        {"secretKey"},
+	"authUrlSignL3":        {"sharedSecretTable"},
+	"authUrlSignLMV":       {"secret"},
+}
+
+func sensitive(t *Type, f *Field) bool {
+	if f.AdvancedType == "PASSWORD" {
+		return true
+	}
+	for _, n := range sensitiveFields[t.Name] {
+		if n == f.Name {
+			return true
+		}
+	}
+	return false
+}
+
+var initialisms = map[string]bool{
+	"acl":  true,
+	"dns":  true,
+	"http": true,
+	"id":   true,
+	"ip":   true,
+	"ttl":  true,
+	"uri":  true,
+	"url":  true,
+}
+
+var goTypes = map[string]string{
+	"boolean": "*bool",
+	"string":  "string",
+	"uint16":  "uint16",
+	"uint32":  "uint32",
+	"int32":   "int32",
+}
+
+// goName convert json name to exported go name, known initialisms are upper cased
+func goName(name string) string {
+	words := []string{}
+	start := 0
+	rs := []rune(name)
+	for i := 1; i < len(rs); i++ {
+		if unicode.IsUpper(rs[i]) && (unicode.IsLower(rs[i-1]) || unicode.IsDigit(rs[i-1])) {
+			words = append(words, string(rs[start:i]))
+			start = i
+		}
+	}
+	words = append(words, string(rs[start:]))
+	for i, w := range words {
+		letters := strings.TrimRightFunc(w, unicode.IsDigit)
+		if initialisms[strings.ToLower(letters)] {
+			words[i] = strings.ToUpper(letters) + w[len(letters):]
+		} else {
+			words[i] = upperFirst(w)
+		}
+	}
+	return strings.Join(words, "")
+}
+
+func upperFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
+
+func typeName(name string) string {
+	if n, ok := typeNames[name]; ok {
+		return n
+	}
+	return goName(name)
+}
+
+func fieldName(name string) string {
+	if n, ok := fieldNames[name]; ok {
+		return n
+	}
+	return goName(name)
+}
+
+func comment(b *bytes.Buffer, indent, first, text string) {
+	for i, l := range strings.Split(text, "\n") {
+		if i == 0 {
+			l = strings.TrimSpace(first + " " + l)
+		}
+		if l == "" {
+			fmt.Fprintf(b, "%s//\n", indent)
+		} else {
+			fmt.Fprintf(b, "%s// %s\n", indent, l)
+		}
+	}
+}
+
+func tag(t *Type, f *Field) string {
+	json := f.Name + ",omitempty"
+	if f.Required {
+		json = f.Name + ","
+	}
+	tg := fmt.Sprintf(`json:"%s" default:"%s" role:"%s" writeonly:"%s"`, json, f.Default, f.Role, f.WriteOnly)
+	for _, kv := range [][2]string{{"list", f.List}, {"enum", f.Enum}, {"range", f.Range}, {"advancedType", f.AdvancedType}, {"hashMap", f.HashMap}} {
+		if kv[1] != "" {
+			tg += fmt.Sprintf(` %s:"%s"`, kv[0], kv[1])
+		}
+	}
+	if sensitive(t, f) {
+		tg += ` sensitive:"true"`
+	}
+	return "`" + tg + "`"
+}
+
+// defaultStmt statement filling field absent from decoded object with its default, empty if default is empty, zero or unparsable
+// zero values returned by API are kept, fields of policies built in code are filled when zero
+func defaultStmt(f *Field) string {
+	n := goName(f.Name)
+	switch f.Type {
+	case "boolean":
+		v, err := strconv.ParseBool(f.Default)
+		if err != nil {
+			return ""
+		}
+		return fmt.Sprintf("if p.%s == nil && !p.has(%q) {\n\t\tp.%s = newBool(%t)\n\t\tp.setDefaulted(%q, %t)\n\t}\n", n, f.Name, n, v, f.Name, v)
+	case "string":
+		if f.Default == "" {
+			return ""
+		}
+		return fmt.Sprintf("if p.%s == \"\" && !p.has(%q) {\n\t\tp.%s = %q\n\t\tp.setDefaulted(%q, p.%s)\n\t}\n", n, f.Name, n, f.Default, f.Name, n)
+	case "uint16", "uint32":
+		v, err := strconv.ParseUint(f.Default, 10, 32)
+		if err != nil || v == 0 {
+			return ""
+		}
+		return fmt.Sprintf("if p.%s == 0 && !p.has(%q) {\n\t\tp.%s = %d\n\t\tp.setDefaulted(%q, p.%s)\n\t}\n", n, f.Name, n, v, f.Name, n)
+	case "int32":
+		v, err := strconv.ParseInt(f.Default, 10, 32)
+		if err != nil || v == 0 {
+			return ""
+		}
+		return fmt.Sprintf("if p.%s == 0 && !p.has(%q) {\n\t\tp.%s = %d\n\t\tp.setDefaulted(%q, p.%s)\n\t}\n", n, f.Name, n, v, f.Name, n)
+	}
+	return ""
+}
+
+// Generate render go source for doc
+func Generate(doc *Doc, docPath string) ([]byte, error) {
+	b := &bytes.Buffer{}
+	fmt.Fprintf(b, "// Code generated by confgen from %s; DO NOT EDIT.\n\npackage hwapi\n\nimport (\n\t\"encoding/json\"\n\t\"reflect\"\n)\n\n", docPath)
+	for _, t := range doc.List {
+		tn := typeName(t.Name)
+		policy := &bytes.Buffer{}
+		if len(t.DefaultPolicy) == 0 {
+			t.DefaultPolicy = json.RawMessage("null")
+		}
+		if err := json.Compact(policy, t.DefaultPolicy); err != nil {
+			return nil, fmt.Errorf("%s: invalid defaultPolicy, %s", t.Name, err.Error())
+		}
+		comment(b, "", tn, t.Description)
+		fmt.Fprintf(b, "// AllowedScope %s\n// DefaultPolicy  %s\ntype %s struct {\n", t.AllowedScope, policy.String(), tn)
+		fmt.Fprintf(b, "\t// ID configurationID, used when update configuration\n\tID int64 `json:\"id,omitempty\"`\n")
+		for _, f := range t.Fields {
+			gt, ok := goTypes[f.Type]
+			if !ok {
+				return nil, fmt.Errorf("%s.%s: unsupported type %s", t.Name, f.Name, f.Type)
+			}
+			b.WriteString("\n")
+			comment(b, "\t", upperFirst(f.Name), f.Description)
+			fmt.Fprintf(b, "\t%s %s %s\n", goName(f.Name), gt, tag(t, f))
+		}
+		b.WriteString("\n\t// Extra fields not listed in doc, kept for round trip\n\tExtra map[string]json.RawMessage `json:\"-\"`\n\n\tpolicyMeta\n}\n\n")
+		fmt.Fprintf(b, "// MarshalJSON include Extra fields\nfunc (p *%s) MarshalJSON() ([]byte, error) {\n\ttype t %s\n\treturn marshalExtra((*t)(p), p.Extra)\n}\n\n", tn, tn)
+		fmt.Fprintf(b, "// UnmarshalJSON keep unknown fields in Extra\nfunc (p *%s) UnmarshalJSON(b []byte) error {\n\ttype t %s\n\tvar e error\n\tp.Extra, e = unmarshalExtra(b, (*t)(p), %q)\n\treturn e\n}\n\n", tn, tn, t.Name)
+		fmt.Fprintf(b, "// setDefaults fill absent fields with doc defaults\nfunc (p *%s) setDefaults() {\n", tn)
+		for _, f := range t.Fields {
+			if stmt := defaultStmt(f); stmt != "" {
+				b.WriteString("\t" + stmt)
+			}
+		}
+		b.WriteString("}\n\n")
+	}
+
+	b.WriteString("// Configuration A container for configuration on a scope\ntype Configuration struct {\n")
+	b.WriteString("\tID string `json:\"id,omitempty\"` //For updates, this is the configuration receipt id that can be used to poll for status\n")
+	b.WriteString("\tScope Scope `json:\"scope\"` //The scope at which this configuration is set\n")
+	for _, t := range doc.List {
+		ft := "*" + typeName(t.Name)
+		if t.Groupable {
+			ft = "[]" + ft
+		}
+		fmt.Fprintf(b, "\t%s %s `json:\"%s,omitempty\"`\n", fieldName(t.Name), ft, t.Name)
+		if h, ok := handWritten[t.Name]; ok {
+			fmt.Fprintf(b, "\t%s *%s `json:\"%s,omitempty\"`\n", h[1], h[1], h[0])
+		}
+	}
+	b.WriteString("\n\t// Extra policies not listed in doc, kept for round trip\n\tExtra map[string]json.RawMessage `json:\"-\"`\n}\n\n")
+
+	b.WriteString("// setDefaults fill doc defaults of every policy\nfunc (c *Configuration) setDefaults() {\n")
+	for _, t := range doc.List {
+		if t.Groupable {
+			fmt.Fprintf(b, "\tfor _, p := range c.%s {\n\t\tif p != nil {\n\t\t\tp.setDefaults()\n\t\t}\n\t}\n", fieldName(t.Name))
+		} else {
+			fmt.Fprintf(b, "\tif c.%s != nil {\n\t\tc.%s.setDefaults()\n\t}\n", fieldName(t.Name), fieldName(t.Name))
+		}
+	}
+	b.WriteString("}\n\n")
+
+	b.WriteString("// policies all configuration types listed in configuration doc\nvar policies = []*PolicyType{\n")
+	for _, t := range doc.List {
+		fmt.Fprintf(b, "\t{Name: %q, Field: %q, AllowedScope: %q, Groupable: %t, Type: reflect.TypeOf(%s{})},\n",
+			t.Name, fieldName(t.Name), t.AllowedScope, t.Groupable, typeName(t.Name))
+	}
+	b.WriteString("}\n")
+	return format.Source(b.Bytes())
+}
+
+func main() {
+	docPath := flag.String("doc", "schema/configuration.json", "saved configuration doc, the output of GetConfigurationDoc")
+	out := flag.String("out", "configuration_types.go", "generated go file")
+	flag.Parse()
+
+	d, err := ioutil.ReadFile(*docPath)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	doc := &Doc{}
+	if err := json.Unmarshal(d, doc); err != nil {
+		fmt.Fprintf(os.Stderr, "parse %s failed, %s\n", *docPath, err.Error())
+		os.Exit(1)
+	}
+	src, err := Generate(doc, *docPath)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	if err := ioutil.WriteFile(*out, src, 0644); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,235 @@
+package hwapi_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/bucloud/hwapi"
+)
+
+type docField struct {
+	Name         string `json:"name"`
+	Type         string `json:"type"`
+	Required     bool   `json:"required"`
+	Default      string `json:"default"`
+	Role         string `json:"role"`
+	WriteOnly    string `json:"writeOnly"`
+	List         string `json:"list"`
+	Enum         string `json:"enum"`
+	Range        string `json:"range"`
+	AdvancedType string `json:"advancedType"`
+	HashMap      string `json:"hashMap"`
+}
+
+type docType struct {
+	Name         string      `json:"name"`
+	AllowedScope string      `json:"allowedScope"`
+	Groupable    bool        `json:"groupable"`
+	Fields       []*docField `json:"fields"`
+}
+
+var docKinds = map[string]string{
+	"boolean": "*bool",
+	"string":  "string",
+	"uint16":  "uint16",
+	"uint32":  "uint32",
+	"int32":   "int32",
+}
+
+// TestConfigurationDrift make sure generated structs still match schema/configuration.json,
+// run go generate if this test failed
+func TestConfigurationDrift(t *testing.T) {
+	b, err := ioutil.ReadFile("schema/configuration.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	doc := &struct {
+		List []*docType `json:"list"`
+	}{}
+	if err := json.Unmarshal(b, doc); err != nil {
+		t.Fatal(err)
+	}
+	if len(doc.List) != len(hwapi.Policies()) {
+		t.Errorf("doc contains %d types, but %d policies registered", len(doc.List), len(hwapi.Policies()))
+	}
+	conf := reflect.TypeOf(hwapi.Configuration{})
+	for _, dt := range doc.List {
+		p := hwapi.PolicyByName(dt.Name)
+		if p == nil {
+			t.Errorf("%s: missed in registry", dt.Name)
+			continue
+		}
+		if p.AllowedScope != dt.AllowedScope || p.Groupable != dt.Groupable {
+			t.Errorf("%s: registry %s/%t, doc %s/%t", dt.Name, p.AllowedScope, p.Groupable, dt.AllowedScope, dt.Groupable)
+		}
+		cf, ok := conf.FieldByName(p.Field)
+		if !ok || strings.Split(cf.Tag.Get("json"), ",")[0] != dt.Name {
+			t.Errorf("%s: Configuration.%s missed or json name mismatch", dt.Name, p.Field)
+		}
+		fields := map[string]reflect.StructField{}
+		for i := 0; i < p.Type.NumField(); i++ {
+			f := p.Type.Field(i)
+			if f.Name == "Extra" || f.PkgPath != "" {
+				continue
+			}
+			fields[strings.Split(f.Tag.Get("json"), ",")[0]] = f
+		}
+		if len(fields) != len(dt.Fields)+1 {
+			t.Errorf("%s: struct contains %d fields, doc %d", dt.Name, len(fields)-1, len(dt.Fields))
+		}
+		for _, df := range dt.Fields {
+			f, ok := fields[df.Name]
+			if !ok {
+				t.Errorf("%s.%s: missed in struct", dt.Name, df.Name)
+				continue
+			}
+			if f.Type.String() != docKinds[df.Type] {
+				t.Errorf("%s.%s: type %s, doc %s", dt.Name, df.Name, f.Type, df.Type)
+			}
+			required := strings.HasSuffix(f.Tag.Get("json"), ",")
+			for _, kv := range [][3]string{
+				{"default", f.Tag.Get("default"), df.Default},
+				{"role", f.Tag.Get("role"), df.Role},
+				{"writeonly", f.Tag.Get("writeonly"), df.WriteOnly},
+				{"list", f.Tag.Get("list"), df.List},
+				{"enum", f.Tag.Get("enum"), df.Enum},
+				{"range", f.Tag.Get("range"), df.Range},
+				{"advancedType", f.Tag.Get("advancedType"), df.AdvancedType},
+				{"hashMap", f.Tag.Get("hashMap"), df.HashMap},
+			} {
+				if kv[1] != kv[2] {
+					t.Errorf("%s.%s: %s tag %q, doc %q", dt.Name, df.Name, kv[0], kv[1], kv[2])
+				}
+			}
+			if required != df.Required {
+				t.Errorf("%s.%s: required %t, doc %t", dt.Name, df.Name, required, df.Required)
+			}
+		}
+	}
+}
+
+func TestConfigurationUnknownFields(t *testing.T) {
+	unknown := []string{}
+	hwapi.OnUnknownField = func(policy string, field string) {
+		unknown = append(unknown, policy+"."+field)
+	}
+	defer func() { hwapi.OnUnknownField = nil }()
+
+	src := `{"scope":{"id":1},"newPolicy":{"a":1},"originPullPolicy":[{"id":2,"expireSeconds":60,"newField":"x"}]}`
+	c := &hwapi.Configuration{}
+	if e := json.Unmarshal([]byte(src), c); e != nil {
+		t.Fatal(e)
+	}
+	sort.Strings(unknown)
+	if strings.Join(unknown, " ") != ".newPolicy originPullPolicy.newField" {
+		t.Errorf("unknown fields reported %v", unknown)
+	}
+	b, e := json.Marshal(c)
+	if e != nil {
+		t.Fatal(e)
+	}
+	out := map[string]interface{}{}
+	json.Unmarshal(b, &out)
+	if _, ok := out["newPolicy"]; !ok {
+		t.Errorf("unknown policy dropped: %s", b)
+	}
+	if p := out["originPullPolicy"].([]interface{})[0].(map[string]interface{}); p["newField"] != "x" {
+		t.Errorf("unknown field dropped: %s", b)
+	}
+}
+
+func TestConfigurationMarshalMode(t *testing.T) {
+	c := &hwapi.Configuration{}
+	if e := json.Unmarshal([]byte(`{"authHttpBasic":{"bindingPoint":"http://a.com","ttl":60},"originPullPolicy":[{"expireSeconds":60},{"expireSeconds":30,"enabled":true}]}`), c); e != nil {
+		t.Fatal(e)
+	}
+	if !c.AuthHTTPBasic.Defaulted("connectCount") || c.AuthHTTPBasic.Defaulted("ttl") {
+		t.Errorf("defaulted fields %v", c.AuthHTTPBasic.DefaultedFields())
+	}
+	c.AuthHTTPBasic.ConnectCount = 10
+	c.OriginPullPolicy[1].SetExplicit("pathFilter")
+
+	full, e := c.MarshalWith(hwapi.MarshalDefaulted)
+	if e != nil {
+		t.Fatal(e)
+	}
+	explicit, e := c.MarshalWith(hwapi.MarshalExplicit)
+	if e != nil {
+		t.Fatal(e)
+	}
+	m := map[string]interface{}{}
+	json.Unmarshal(explicit, &m)
+	basic := m["authHttpBasic"].(map[string]interface{})
+	if _, ok := basic["enabled"]; ok {
+		t.Errorf("defaulted enabled sent: %s", explicit)
+	}
+	if basic["connectCount"] != float64(10) {
+		t.Errorf("changed default dropped: %s", explicit)
+	}
+	list := m["originPullPolicy"].([]interface{})
+	if _, ok := list[0].(map[string]interface{})["pathFilter"]; ok {
+		t.Errorf("defaulted pathFilter sent: %s", explicit)
+	}
+	if list[1].(map[string]interface{})["enabled"] != true || list[1].(map[string]interface{})["pathFilter"] != "*" {
+		t.Errorf("explicit fields dropped: %s", explicit)
+	}
+	if len(explicit) >= len(full) {
+		t.Errorf("explicit %d bytes, full %d bytes", len(explicit), len(full))
+	}
+}
+
+func TestConfigurationZeroValueRoundTrip(t *testing.T) {
+	c := &hwapi.Configuration{}
+	if e := json.Unmarshal([]byte(`{"cacheControl":[{"maxAge":0},{"maxAge":null}]}`), c); e != nil {
+		t.Fatal(e)
+	}
+	p := c.CacheControl[0]
+	if p.MaxAge != 0 || p.Defaulted("maxAge") {
+		t.Errorf("maxAge:0 got %d defaulted %t", p.MaxAge, p.Defaulted("maxAge"))
+	}
+	if p = c.CacheControl[1]; p.MaxAge != -1 || !p.Defaulted("maxAge") {
+		t.Errorf("maxAge:null got %d defaulted %t", p.MaxAge, p.Defaulted("maxAge"))
+	}
+	for _, mode := range []hwapi.MarshalMode{hwapi.MarshalDefaulted, hwapi.MarshalExplicit} {
+		b, e := c.MarshalWith(mode)
+		if e != nil {
+			t.Fatal(e)
+		}
+		m := map[string][]map[string]interface{}{}
+		json.Unmarshal(b, &m)
+		if v, ok := m["cacheControl"][0]["maxAge"]; !ok || v != float64(0) {
+			t.Errorf("mode %d: maxAge:0 lost, %s", mode, b)
+		}
+	}
+
+	// zero value set by user is sent once marked explicit
+	p = &hwapi.CacheControl{}
+	p.SetExplicit("maxAge")
+	if b, _ := json.Marshal(p); !strings.Contains(string(b), `"maxAge":0`) {
+		t.Errorf("explicit maxAge:0 dropped, %s", b)
+	}
+}
+
+func TestUpdateConfiguration(t *testing.T) {
+	var method, body string
+	api := newTestAPI(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		method, body = r.Method, string(b)
+		w.Write([]byte(`{"id":"receipt","authHttpBasic":{"ttl":30}}`))
+	}))
+	c, e := api.UpdateConfiguration("a", "h", 1, &hwapi.Configuration{AuthHTTPBasic: &hwapi.AuthHTTPBasic{TTL: 30}})
+	if e != nil {
+		t.Fatal(e)
+	}
+	if method != http.MethodPut || !strings.Contains(body, `"ttl":30`) {
+		t.Errorf("sent %s %s", method, body)
+	}
+	if c.ID != "receipt" || c.AuthHTTPBasic.TTL != 30 {
+		t.Errorf("got %+v", c)
+	}
+}
@@ -0,0 +1,100 @@
+package hwapi_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/bucloud/hwapi"
+)
+
+// allPoliciesJSON configuration contains every policy type with empty values
+func allPoliciesJSON() []byte {
+	m := map[string]interface{}{}
+	for _, p := range hwapi.Policies() {
+		if p.Groupable {
+			m[p.Name] = []interface{}{map[string]interface{}{}, map[string]interface{}{}}
+		} else {
+			m[p.Name] = map[string]interface{}{}
+		}
+	}
+	b, _ := json.Marshal(m)
+	return b
+}
+
+func TestConfigurationDefaults(t *testing.T) {
+	c := &hwapi.Configuration{}
+	if e := json.Unmarshal(allPoliciesJSON(), c); e != nil {
+		t.Fatal(e)
+	}
+	cv := reflect.ValueOf(c).Elem()
+	for _, p := range hwapi.Policies() {
+		v := cv.FieldByName(p.Field)
+		if p.Groupable {
+			v = v.Index(1)
+		}
+		v = v.Elem()
+		for i := 0; i < p.Type.NumField(); i++ {
+			sf := p.Type.Field(i)
+			d, ok := sf.Tag.Lookup("default")
+			if !ok {
+				continue
+			}
+			got := v.Field(i)
+			want := ""
+			switch got.Kind() {
+			case reflect.Ptr:
+				if b, e := strconv.ParseBool(d); e == nil {
+					want = strconv.FormatBool(b)
+				}
+				if got.IsNil() {
+					if want != "" {
+						t.Errorf("%s.%s: nil, want %s", p.Name, sf.Name, want)
+					}
+					continue
+				}
+				got = got.Elem()
+			case reflect.Uint16, reflect.Uint32, reflect.Int32:
+				want = "0"
+				if _, e := strconv.ParseInt(d, 10, 64); e == nil {
+					want = d
+				}
+			default:
+				want = d
+			}
+			if s := strings.Trim(strings.TrimSpace(string(mustJSON(got.Interface()))), `"`); s != want {
+				t.Errorf("%s.%s: %s, want %s", p.Name, sf.Name, s, want)
+			}
+		}
+	}
+}
+
+func TestOriginPullHostDefaults(t *testing.T) {
+	c := &hwapi.Configuration{}
+	if e := json.Unmarshal([]byte(`{"originPullHost":{"primary":1,"methodFilter":"GET"}}`), c); e != nil {
+		t.Fatal(e)
+	}
+	if h := c.OriginPullHost; h.HeaderFilter != "*" || h.PathFilter != "*" || h.MethodFilter != "GET" {
+		t.Errorf("got %+v", h)
+	}
+}
+
+func mustJSON(v interface{}) []byte {
+	b, _ := json.Marshal(v)
+	return b
+}
+
+// BenchmarkConfigurationUnmarshal cost of unmarshalling every policy type with generated default filling,
+// it has no baseline so it tells nothing about speedup
+func BenchmarkConfigurationUnmarshal(b *testing.B) {
+	d := allPoliciesJSON()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		c := &hwapi.Configuration{}
+		if e := json.Unmarshal(d, c); e != nil {
+			b.Fatal(e)
+		}
+	}
+}
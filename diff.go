@@ -0,0 +1,113 @@
+package hwapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// ConfigurationChange single changed value between two configurations
+type ConfigurationChange struct {
+	// Path such like originPullPolicy[0].expireSeconds
+	Path string
+	// Old value, nil means added
+	Old interface{}
+	// New value, nil means removed
+	New interface{}
+}
+
+// String print change, sensitive values are redacted
+func (c *ConfigurationChange) String() string {
+	r := &ConfigurationChange{Path: c.Path, Old: redactChangeValue(c.Path, c.Old), New: redactChangeValue(c.Path, c.New)}
+	return r.Reveal()
+}
+
+// Reveal print change including sensitive values
+func (c *ConfigurationChange) Reveal() string {
+	switch {
+	case c.Old == nil:
+		return fmt.Sprintf("+ %s: %s", c.Path, diffValue(c.New))
+	case c.New == nil:
+		return fmt.Sprintf("- %s: %s", c.Path, diffValue(c.Old))
+	}
+	return fmt.Sprintf("~ %s: %s => %s", c.Path, diffValue(c.Old), diffValue(c.New))
+}
+
+func diffValue(v interface{}) string {
+	b, _ := json.Marshal(v)
+	return string(b)
+}
+
+// DiffConfiguration list changes from src to dst, receipt ID and scope are ignored
+func DiffConfiguration(src, dst *Configuration) ([]*ConfigurationChange, error) {
+	o, e := configurationMap(src)
+	if e != nil {
+		return nil, e
+	}
+	n, e := configurationMap(dst)
+	if e != nil {
+		return nil, e
+	}
+	res := []*ConfigurationChange{}
+	diffWalk("", o, n, &res)
+	return res, nil
+}
+
+func configurationMap(c *Configuration) (map[string]interface{}, error) {
+	m := map[string]interface{}{}
+	if c == nil {
+		return m, nil
+	}
+	b, e := json.Marshal(c)
+	if e != nil {
+		return nil, e
+	}
+	if e := json.Unmarshal(b, &m); e != nil {
+		return nil, e
+	}
+	delete(m, "id")
+	delete(m, "scope")
+	return m, nil
+}
+
+func diffWalk(path string, o, n interface{}, res *[]*ConfigurationChange) {
+	if reflect.DeepEqual(o, n) {
+		return
+	}
+	om, ook := o.(map[string]interface{})
+	nm, nok := n.(map[string]interface{})
+	if ook && nok {
+		keys := []string{}
+		for k := range om {
+			keys = append(keys, k)
+		}
+		for k := range nm {
+			if _, ok := om[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			diffWalk(strings.TrimPrefix(path+"."+k, "."), om[k], nm[k], res)
+		}
+		return
+	}
+	ol, ook := o.([]interface{})
+	nl, nok := n.([]interface{})
+	if ook && nok {
+		for i := 0; i < len(ol) || i < len(nl); i++ {
+			var ov, nv interface{}
+			if i < len(ol) {
+				ov = ol[i]
+			}
+			if i < len(nl) {
+				nv = nl[i]
+			}
+			diffWalk(fmt.Sprintf("%s[%d]", path, i), ov, nv, res)
+		}
+		return
+	}
+	*res = append(*res, &ConfigurationChange{Path: path, Old: o, New: n})
+}
@@ -0,0 +1,156 @@
+package hwapi
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Scope levels, configuration of deeper level overrides upper ones
+const (
+	ScopeLevelRoot    = "ROOT"
+	ScopeLevelProduct = "PRODUCT"
+	ScopeLevelDir     = "DIR"
+)
+
+// EffectiveConfiguration merged configuration for a request path
+type EffectiveConfiguration struct {
+	// Configuration merged result, Scope is the deepest scope applied
+	*Configuration
+
+	// Scopes applied scopes, from ROOT to the deepest DIR
+	Scopes []Scope
+
+	// Sources policy name => scope which effective policy came from
+	Sources map[string]Scope
+}
+
+// effectiveConfigurationJSON JSON layout of EffectiveConfiguration, embedded Configuration would hide other fields
+type effectiveConfigurationJSON struct {
+	Configuration *Configuration   `json:"configuration"`
+	Scopes        []Scope          `json:"scopes"`
+	Sources       map[string]Scope `json:"sources"`
+}
+
+// MarshalJSON encode merged configuration together with scopes and sources
+func (ec *EffectiveConfiguration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&effectiveConfigurationJSON{Configuration: ec.Configuration, Scopes: ec.Scopes, Sources: ec.Sources})
+}
+
+// UnmarshalJSON decode output of MarshalJSON
+func (ec *EffectiveConfiguration) UnmarshalJSON(b []byte) error {
+	v := &effectiveConfigurationJSON{}
+	if e := json.Unmarshal(b, v); e != nil {
+		return e
+	}
+	ec.Configuration, ec.Scopes, ec.Sources = v.Configuration, v.Scopes, v.Sources
+	return nil
+}
+
+// ScopeLevel return ROOT/PRODUCT/DIR level of scope
+// platform ALL means ROOT, path / of other platforms means PRODUCT, others are DIR
+func ScopeLevel(s Scope) string {
+	switch {
+	case strings.EqualFold(s.Platform, "ALL"):
+		return ScopeLevelRoot
+	case s.Path == "" || s.Path == "/":
+		return ScopeLevelProduct
+	default:
+		return ScopeLevelDir
+	}
+}
+
+// scopeMatch check whether requestPath is under scope path
+func scopeMatch(scopePath, requestPath string) bool {
+	p := strings.TrimSuffix(scopePath, "/")
+	return p == "" || requestPath == p || strings.HasPrefix(requestPath, p+"/")
+}
+
+// ApplicableScopes filter scopes applied to requestPath of platform, ordered from ROOT to the deepest DIR
+// platform default to CDS
+func ApplicableScopes(scopes []*ConfigScope, platform string, requestPath string) []*ConfigScope {
+	if platform == "" {
+		platform = "CDS"
+	}
+	if !strings.HasPrefix(requestPath, "/") {
+		requestPath = "/" + requestPath
+	}
+	res := []*ConfigScope{}
+	for _, s := range scopes {
+		if !strings.EqualFold(s.Platform, "ALL") && !strings.EqualFold(s.Platform, platform) {
+			continue
+		}
+		if scopeMatch(s.Path, requestPath) {
+			res = append(res, s)
+		}
+	}
+	sort.SliceStable(res, func(i, j int) bool {
+		ri, rj := strings.EqualFold(res[i].Platform, "ALL"), strings.EqualFold(res[j].Platform, "ALL")
+		if ri != rj {
+			return ri
+		}
+		return len(strings.TrimSuffix(res[i].Path, "/")) < len(strings.TrimSuffix(res[j].Path, "/"))
+	})
+	return res
+}
+
+// MergeConfiguration merge configurations ordered from ROOT to the deepest DIR
+// Each policy type defined in a deeper scope replaces the whole type of upper scopes, groupable lists are not concatenated
+func MergeConfiguration(layers ...*Configuration) *EffectiveConfiguration {
+	ec := &EffectiveConfiguration{
+		Configuration: &Configuration{},
+		Scopes:        []Scope{},
+		Sources:       map[string]Scope{},
+	}
+	dst := reflect.ValueOf(ec.Configuration).Elem()
+	for _, l := range layers {
+		if l == nil {
+			continue
+		}
+		ec.Scopes = append(ec.Scopes, l.Scope)
+		ec.Scope = l.Scope
+		src := reflect.ValueOf(l).Elem()
+		for _, p := range policies {
+			v := src.FieldByName(p.Field)
+			if v.IsNil() || (p.Groupable && v.Len() == 0) {
+				continue
+			}
+			dst.FieldByName(p.Field).Set(v)
+			ec.Sources[p.Name] = l.Scope
+		}
+		if l.OriginPullHost != nil {
+			ec.OriginPullHost = l.OriginPullHost
+			ec.Sources["originPullHost"] = l.Scope
+		}
+		for k, v := range l.Extra {
+			if ec.Extra == nil {
+				ec.Extra = map[string]json.RawMessage{}
+			}
+			ec.Extra[k] = v
+			ec.Sources[k] = l.Scope
+		}
+	}
+	return ec
+}
+
+// GetEffectiveConfiguration load all scopes of host applied to requestPath and merge them
+// platform default to CDS
+func (api *HWApi) GetEffectiveConfiguration(accountHash string, hostHash string, platform string, requestPath string) (*EffectiveConfiguration, error) {
+	sl, e := api.GetScopes(accountHash, hostHash)
+	if e != nil {
+		return nil, e
+	}
+	layers := []*Configuration{}
+	for _, s := range ApplicableScopes(sl.List, platform, requestPath) {
+		c, e := api.GetConfiguration(accountHash, hostHash, s.ID)
+		if e != nil {
+			return nil, e
+		}
+		if c.Scope.ID == 0 {
+			c.Scope = Scope{ID: s.ID, Path: s.Path, Platform: s.Platform, Name: s.Name, CreatedDate: s.CreatedDate, UpdatedDate: s.UpdatedDate}
+		}
+		layers = append(layers, c)
+	}
+	return MergeConfiguration(layers...), nil
+}
@@ -0,0 +1,115 @@
+package hwapi_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/bucloud/hwapi"
+)
+
+var effectiveScopes = []*hwapi.ConfigScope{
+	{ID: 4, Platform: "CDS", Path: "/img/thumb"},
+	{ID: 1, Platform: "ALL", Path: "/"},
+	{ID: 3, Platform: "CDS", Path: "/img"},
+	{ID: 2, Platform: "CDS", Path: "/"},
+	{ID: 5, Platform: "CDS", Path: "/images"},
+	{ID: 6, Platform: "SDS", Path: "/"},
+}
+
+func TestApplicableScopes(t *testing.T) {
+	for _, c := range []struct {
+		platform string
+		path     string
+		want     string
+	}{
+		{"", "/img/thumb/1.png", "1,2,3,4"},
+		{"cds", "img/1.png", "1,2,3"},
+		{"CDS", "/img", "1,2,3"},
+		{"CDS", "/imgs/1.png", "1,2"},
+		{"SDS", "/img/1.png", "1,6"},
+	} {
+		ids := []string{}
+		for _, s := range hwapi.ApplicableScopes(effectiveScopes, c.platform, c.path) {
+			ids = append(ids, fmt.Sprint(s.ID))
+		}
+		if got := strings.Join(ids, ","); got != c.want {
+			t.Errorf("%s %s: got %s, want %s", c.platform, c.path, got, c.want)
+		}
+	}
+}
+
+func TestMergeConfiguration(t *testing.T) {
+	root := &hwapi.Configuration{}
+	json.Unmarshal([]byte(`{"scope":{"id":1,"platform":"ALL","path":"/"},"cacheControl":[{"maxAge":60},{"maxAge":120}],"authHttpBasic":{"ttl":10},"newPolicy":{"a":1}}`), root)
+	dir := &hwapi.Configuration{}
+	json.Unmarshal([]byte(`{"scope":{"id":3,"platform":"CDS","path":"/img"},"cacheControl":[{"maxAge":0}],"authHttpBasic":null}`), dir)
+
+	ec := hwapi.MergeConfiguration(root, nil, dir)
+	if ec.Scope.ID != 3 || len(ec.Scopes) != 2 {
+		t.Errorf("scope %v, scopes %v", ec.Scope, ec.Scopes)
+	}
+	if len(ec.CacheControl) != 1 || ec.CacheControl[0].MaxAge != 0 || ec.Sources["cacheControl"].ID != 3 {
+		t.Errorf("deeper cacheControl should replace the whole list, got %v from %v", ec.CacheControl, ec.Sources["cacheControl"])
+	}
+	if ec.AuthHTTPBasic == nil || ec.AuthHTTPBasic.TTL != 10 || ec.Sources["authHttpBasic"].ID != 1 {
+		t.Errorf("authHttpBasic should be inherited from root, got %v", ec.AuthHTTPBasic)
+	}
+	if _, ok := ec.Extra["newPolicy"]; !ok || ec.Sources["newPolicy"].ID != 1 {
+		t.Errorf("unknown policy dropped, %v", ec.Extra)
+	}
+
+	b, e := json.Marshal(ec)
+	if e != nil {
+		t.Fatal(e)
+	}
+	out := &hwapi.EffectiveConfiguration{}
+	if e := json.Unmarshal(b, out); e != nil {
+		t.Fatal(e)
+	}
+	if out.Configuration == nil || len(out.CacheControl) != 1 || len(out.Scopes) != 2 || out.Sources["authHttpBasic"].ID != 1 {
+		t.Errorf("scopes or sources lost on marshal, %s", b)
+	}
+}
+
+func TestGetEffectiveConfiguration(t *testing.T) {
+	scopes, _ := json.Marshal(&hwapi.ConfigScopeList{List: effectiveScopes})
+	confs := map[string]string{
+		"1": `{"scope":{"id":1,"platform":"ALL","path":"/"},"cacheControl":[{"maxAge":60}]}`,
+		"2": `{"scope":{"id":2,"platform":"CDS","path":"/"},"authHttpBasic":{"ttl":10}}`,
+		"3": `{"cacheControl":[{"maxAge":300}]}`,
+	}
+	requested := []string{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/accounts/a/hosts/h/configuration/", func(w http.ResponseWriter, r *http.Request) {
+		id := strings.TrimPrefix(r.URL.Path, "/api/v1/accounts/a/hosts/h/configuration/")
+		if id == "scopes" {
+			w.Write(scopes)
+			return
+		}
+		requested = append(requested, id)
+		c, ok := confs[id]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte(c))
+	})
+	api := newTestAPI(t, mux)
+
+	ec, e := api.GetEffectiveConfiguration("a", "h", "", "/img/1.png")
+	if e != nil {
+		t.Fatal(e)
+	}
+	if strings.Join(requested, ",") != "1,2,3" {
+		t.Errorf("requested scopes %v", requested)
+	}
+	if ec.Scope.ID != 3 || ec.Scope.Path != "/img" || ec.CacheControl[0].MaxAge != 300 || ec.AuthHTTPBasic.TTL != 10 {
+		t.Errorf("got scope %v, cacheControl %v, authHttpBasic %v", ec.Scope, ec.CacheControl[0], ec.AuthHTTPBasic)
+	}
+	if _, e := api.GetEffectiveConfiguration("a", "h", "", "/img/thumb/1.png"); e == nil {
+		t.Error("expect error when scope configuration is missing")
+	}
+}
@@ -0,0 +1,114 @@
+package hwapi
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// OnUnknownField called when decoded configuration contains field not known by this version
+// policy is empty for top-level keys of Configuration, such fields are kept in Extra and sent back on update
+var OnUnknownField func(policy string, field string)
+
+// knownFields JSON names of struct fields, reflect.Type => map[string]bool
+var knownFields sync.Map
+
+func jsonFieldNames(t reflect.Type) map[string]bool {
+	if v, ok := knownFields.Load(t); ok {
+		return v.(map[string]bool)
+	}
+	names := map[string]bool{}
+	for i := 0; i < t.NumField(); i++ {
+		n := strings.Split(t.Field(i).Tag.Get("json"), ",")[0]
+		if n == "-" {
+			continue
+		}
+		if n == "" {
+			n = t.Field(i).Name
+		}
+		names[n] = true
+	}
+	knownFields.Store(t, names)
+	return names
+}
+
+// unmarshalExtra decode b into v, pointer of struct, and return fields not defined in struct
+func unmarshalExtra(b []byte, v interface{}, policy string) (map[string]json.RawMessage, error) {
+	if e := json.Unmarshal(b, v); e != nil {
+		return nil, e
+	}
+	raw := map[string]json.RawMessage{}
+	if e := json.Unmarshal(b, &raw); e != nil {
+		// null
+		return nil, nil
+	}
+	if pt, ok := v.(presenceTracker); ok {
+		pt.setPresent(raw)
+	}
+	known := jsonFieldNames(reflect.TypeOf(v).Elem())
+	var extra map[string]json.RawMessage
+	for k, r := range raw {
+		if known[k] {
+			continue
+		}
+		if extra == nil {
+			extra = map[string]json.RawMessage{}
+		}
+		extra[k] = r
+		if OnUnknownField != nil {
+			OnUnknownField(policy, k)
+		}
+	}
+	return extra, nil
+}
+
+// presentZeros zero values of present fields which are dropped by omitempty
+func presentZeros(v interface{}) (map[string]json.RawMessage, error) {
+	pt, ok := v.(presenceTracker)
+	if !ok || len(pt.presentFields()) == 0 {
+		return nil, nil
+	}
+	present := pt.presentFields()
+	rv := reflect.ValueOf(v).Elem()
+	var zeros map[string]json.RawMessage
+	for i := 0; i < rv.NumField(); i++ {
+		tag := strings.Split(rv.Type().Field(i).Tag.Get("json"), ",")
+		if len(tag) < 2 || tag[1] != "omitempty" || !present[tag[0]] || !rv.Field(i).IsZero() {
+			continue
+		}
+		b, e := json.Marshal(rv.Field(i).Interface())
+		if e != nil {
+			return nil, e
+		}
+		if zeros == nil {
+			zeros = map[string]json.RawMessage{}
+		}
+		zeros[tag[0]] = b
+	}
+	return zeros, nil
+}
+
+// marshalExtra encode v and append extra fields, zero values of fields present on decode are kept
+func marshalExtra(v interface{}, extra map[string]json.RawMessage) ([]byte, error) {
+	b, e := json.Marshal(v)
+	if e != nil {
+		return nil, e
+	}
+	zeros, e := presentZeros(v)
+	if e != nil || len(extra)+len(zeros) == 0 {
+		return b, e
+	}
+	m := map[string]json.RawMessage{}
+	if e := json.Unmarshal(b, &m); e != nil {
+		return nil, e
+	}
+	for _, add := range []map[string]json.RawMessage{zeros, extra} {
+		for k, r := range add {
+			if _, ok := m[k]; !ok {
+				m[k] = r
+			}
+		}
+	}
+	return json.Marshal(m)
+}
@@ -0,0 +1,467 @@
+package hwapi
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// Filter pattern match types
+const (
+	// MatchDefault pattern without type prefix, treated as fnmatch pattern, '*' matches '/' as well
+	MatchDefault  = ""
+	MatchWildcard = "wildcard"
+	MatchGlob     = "glob"
+	MatchRegex    = "regex"
+)
+
+var (
+	filterCache = sync.Map{}
+
+	urlFilterPattern   = regexp.MustCompile(`^[a-zA-Z*?\[\]]+://`)
+	urlRegexPattern    = regexp.MustCompile(`^\^?\(?[a-zA-Z|?*\[\]]+\)?\??:(\\?/){2}`)
+	filterTypePrefixes = []string{MatchWildcard, MatchGlob, MatchRegex}
+)
+
+// FilterPattern single element of filter list
+type FilterPattern struct {
+	// Raw element as written in policy
+	Raw string
+	// Type wildcard/glob/regex, empty means fnmatch
+	Type string
+	// Exclude element starts with exclamation point
+	Exclude bool
+	// URL expression starts with [protocol]://, matched against protocol://host/path instead of path
+	URL bool
+	// Expr expression without prefixes
+	Expr string
+
+	re *regexp.Regexp
+}
+
+// FilterList parsed filter list, such like pathFilter/headerFilter/methodFilter/popFilter
+type FilterList struct {
+	Raw      string
+	Patterns []*FilterPattern
+}
+
+// filterTypePrefix match type prefix of element, empty if element doesn't start with one
+func filterTypePrefix(elem string) string {
+	body := strings.TrimLeft(strings.TrimPrefix(strings.TrimLeft(strings.TrimPrefix(elem, "(?i)"), " "), "!"), " ")
+	for _, t := range filterTypePrefixes {
+		if strings.HasPrefix(strings.ToLower(body), t+":") {
+			return t
+		}
+	}
+	return ""
+}
+
+// splitFilterList split comma delimited list, comma inside regex:/.../ is kept
+// Type prefix applies to following elements without prefix, so regex:/a/,/b/ contains two regular expressions
+func splitFilterList(expr string) []string {
+	res := []string{}
+	inRegex := false
+	for len(expr) > 0 {
+		s := strings.TrimLeft(expr, " ")
+		body := strings.TrimPrefix(strings.TrimPrefix(s, "!"), "(?i)")
+		if t := filterTypePrefix(s); t != "" {
+			inRegex = t == MatchRegex
+		}
+		end := -1
+		if r := body; inRegex {
+			if strings.HasPrefix(strings.ToLower(r), MatchRegex+":") {
+				r = r[len(MatchRegex)+1:]
+			}
+			r = strings.TrimLeft(strings.TrimPrefix(strings.TrimLeft(r, " "), "(?i)"), " ")
+			offset := len(s) - len(r)
+			if strings.HasPrefix(r, "/") {
+				// regex ends with '/' followed by ',' or end of list
+				for i := 1; i < len(r); i++ {
+					if r[i] == '/' && r[i-1] != '\\' && (i == len(r)-1 || strings.HasPrefix(strings.TrimLeft(r[i+1:], " "), ",")) {
+						end = strings.Index(s[offset+i:], ",")
+						if end >= 0 {
+							end += offset + i
+						}
+						break
+					}
+				}
+				if end < 0 {
+					res = append(res, strings.TrimSpace(s))
+					break
+				}
+			}
+		}
+		if end < 0 {
+			end = strings.Index(s, ",")
+		}
+		if end < 0 {
+			res = append(res, strings.TrimSpace(s))
+			break
+		}
+		if e := strings.TrimSpace(s[:end]); e != "" {
+			res = append(res, e)
+		}
+		expr = s[end+1:]
+	}
+	return res
+}
+
+// wildcardToRegexp convert wildcard/glob expression to regexp, glob '*' and '?' don't match '/'
+func wildcardToRegexp(expr string, matchSlash bool) string {
+	many, one := ".*", "."
+	if !matchSlash {
+		many, one = "[^/]*", "[^/]"
+	}
+	b := &strings.Builder{}
+	b.WriteString("^")
+	for i := 0; i < len(expr); i++ {
+		switch c := expr[i]; c {
+		case '*':
+			b.WriteString(many)
+		case '?':
+			b.WriteString(one)
+		case '\\':
+			if i+1 < len(expr) {
+				i++
+				b.WriteString(regexp.QuoteMeta(expr[i : i+1]))
+			} else {
+				b.WriteString(`\\`)
+			}
+		case '[':
+			if j := strings.IndexByte(expr[i+1:], ']'); j > 0 {
+				class := expr[i+1 : i+1+j]
+				if strings.HasPrefix(class, "!") {
+					class = "^" + class[1:]
+				}
+				b.WriteString("[" + strings.Replace(class, `\`, `\\`, -1) + "]")
+				i += j + 1
+			} else {
+				b.WriteString(`\[`)
+			}
+		default:
+			b.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	b.WriteString("$")
+	return b.String()
+}
+
+// ParseFilterPattern parse single filter element
+// Syntax [!][wildcard:|glob:|regex:][(?i)]expression, regex expression should been surrounded by "/"
+func ParseFilterPattern(raw string) (*FilterPattern, error) {
+	return parseFilterPattern(raw, MatchDefault)
+}
+
+// parseFilterPattern parse element of list, typ is used when element doesn't have type prefix
+func parseFilterPattern(raw string, typ string) (*FilterPattern, error) {
+	p := &FilterPattern{Raw: raw, Type: typ}
+	s := strings.TrimSpace(raw)
+	ci := false
+	if strings.HasPrefix(s, "(?i)") {
+		ci, s = true, strings.TrimSpace(s[4:])
+	}
+	if strings.HasPrefix(s, "!") {
+		p.Exclude, s = true, strings.TrimSpace(s[1:])
+	}
+	for _, t := range filterTypePrefixes {
+		if strings.HasPrefix(strings.ToLower(s), t+":") {
+			p.Type, s = t, strings.TrimSpace(s[len(t)+1:])
+			break
+		}
+	}
+	if strings.HasPrefix(s, "(?i)") {
+		ci, s = true, strings.TrimSpace(s[4:])
+	}
+	var expr string
+	switch p.Type {
+	case MatchRegex:
+		if len(s) < 2 || !strings.HasPrefix(s, "/") || !strings.HasSuffix(s, "/") {
+			return nil, fmt.Errorf("regex filter %q should been surrounded by \"/\"", raw)
+		}
+		s = s[1 : len(s)-1]
+		p.URL = urlRegexPattern.MatchString(strings.TrimPrefix(s, "(?i)"))
+		expr = s
+	case MatchGlob:
+		p.URL = urlFilterPattern.MatchString(s)
+		expr = wildcardToRegexp(s, false)
+	default:
+		p.URL = urlFilterPattern.MatchString(s)
+		expr = wildcardToRegexp(s, true)
+	}
+	p.Expr = s
+	if ci && !strings.HasPrefix(expr, "(?i)") {
+		expr = "(?i)" + expr
+	}
+	re, e := regexp.Compile(expr)
+	if e != nil {
+		return nil, fmt.Errorf("invalid filter %q, %s", raw, e.Error())
+	}
+	p.re = re
+	return p, nil
+}
+
+// ParseFilter parse comma delimited filter list, empty list means "*"
+// parsed filters are cached, so it's cheap to call this repeatedly
+func ParseFilter(expr string) (*FilterList, error) {
+	if f, ok := filterCache.Load(expr); ok {
+		return f.(*FilterList), nil
+	}
+	f := &FilterList{Raw: expr}
+	list := splitFilterList(expr)
+	if len(list) == 0 {
+		list = []string{"*"}
+	}
+	typ := MatchDefault
+	for _, e := range list {
+		p, err := parseFilterPattern(e, typ)
+		if err != nil {
+			return nil, err
+		}
+		typ = p.Type
+		f.Patterns = append(f.Patterns, p)
+	}
+	filterCache.Store(expr, f)
+	return f, nil
+}
+
+// Match check whether subject matches this pattern, exclamation point is ignored
+// wildcard and glob patterns must match whole subject, regex matches any part of subject unless anchored
+func (p *FilterPattern) Match(subject string) bool {
+	return p.re.MatchString(subject)
+}
+
+// Mixed both include and exclude patterns exist, which is not recommended
+func (f *FilterList) Mixed() bool {
+	inc, exc := false, false
+	for _, p := range f.Patterns {
+		if p.Exclude {
+			exc = true
+		} else {
+			inc = true
+		}
+	}
+	return inc && exc
+}
+
+// MatchFunc evaluate filter, subject return values to match for each pattern
+// value matches if any include pattern matched(or no include patterns) and no exclude pattern matched
+func (f *FilterList) MatchFunc(subject func(p *FilterPattern) []string) bool {
+	hasInclude, included := false, false
+	for _, p := range f.Patterns {
+		matched := false
+		for _, s := range subject(p) {
+			if p.Match(s) {
+				matched = true
+				break
+			}
+		}
+		if p.Exclude {
+			if matched {
+				return false
+			}
+			continue
+		}
+		hasInclude = true
+		included = included || matched
+	}
+	return !hasInclude || included
+}
+
+// Match evaluate filter against single value
+func (f *FilterList) Match(s string) bool {
+	return f.MatchFunc(func(*FilterPattern) []string { return []string{s} })
+}
+
+// MatchURL evaluate path filter, URL patterns are matched against protocol://host/path, others against path only
+func (f *FilterList) MatchURL(u *url.URL) bool {
+	p := u.EscapedPath()
+	if p == "" {
+		p = "/"
+	}
+	full := strings.ToLower(u.Scheme) + "://" + strings.ToLower(u.Host) + p
+	return f.MatchFunc(func(fp *FilterPattern) []string {
+		if fp.URL {
+			return []string{full}
+		}
+		if fp.Type == MatchGlob && !strings.HasPrefix(fp.Expr, "/") {
+			// glob:DIR/*.html is relative to root
+			return []string{strings.TrimPrefix(p, "/")}
+		}
+		return []string{p}
+	})
+}
+
+// MatchHeader evaluate header filter, each "Name: value" line of header is matched
+func (f *FilterList) MatchHeader(h http.Header) bool {
+	lines := []string{}
+	for k, vs := range h {
+		for _, v := range vs {
+			lines = append(lines, http.CanonicalHeaderKey(k)+": "+v)
+		}
+	}
+	if len(lines) == 0 {
+		// so that "*" still matches request without headers
+		lines = append(lines, "")
+	}
+	return f.MatchFunc(func(fp *FilterPattern) []string {
+		if fp.Type == MatchRegex {
+			return lines
+		}
+		// header name is case-insensitive, compare with name written in pattern
+		name := fp.Expr
+		if i := strings.Index(name, ":"); i > 0 {
+			name = strings.TrimSpace(name[:i])
+		}
+		res := make([]string, 0, len(lines))
+		for _, l := range lines {
+			if i := strings.Index(l, ":"); i > 0 && strings.EqualFold(l[:i], name) {
+				l = name + l[i:]
+			}
+			res = append(res, l)
+		}
+		return res
+	})
+}
+
+// PolicyRequest request used to evaluate policy filters locally
+type PolicyRequest struct {
+	Method string
+	URL    *url.URL
+	Header http.Header
+	// POP code, such like lax
+	POP string
+	// Region code of POP
+	Region string
+}
+
+// NewPolicyRequest create PolicyRequest, method default to GET
+func NewPolicyRequest(method string, rawURL string, header http.Header, pop string) (*PolicyRequest, error) {
+	u, e := url.Parse(rawURL)
+	if e != nil {
+		return nil, e
+	}
+	if u.Scheme == "" {
+		u.Scheme = "http"
+	}
+	if method == "" {
+		method = GET
+	}
+	if header == nil {
+		header = http.Header{}
+	}
+	return &PolicyRequest{Method: strings.ToUpper(method), URL: u, Header: header, POP: pop}, nil
+}
+
+// FilterResult evaluate result of single filter field
+type FilterResult struct {
+	// Field JSON name of filter field, such like pathFilter
+	Field   string
+	Expr    string
+	Matched bool
+	Err     error
+}
+
+// requestFilterFields filter fields evaluated against request, other filters such like clientResponseCodeFilter depend on response
+var requestFilterFields = []string{"pathFilter", "methodFilter", "headerFilter", "popFilter", "regionFilter"}
+
+// EvaluateFilter evaluate filter of given kind(pathFilter/methodFilter/headerFilter/popFilter/regionFilter) against request
+func EvaluateFilter(kind string, expr string, req *PolicyRequest) (bool, error) {
+	f, e := ParseFilter(expr)
+	if e != nil {
+		return false, e
+	}
+	switch kind {
+	case "pathFilter":
+		return f.MatchURL(req.URL), nil
+	case "methodFilter":
+		return f.MatchFunc(func(*FilterPattern) []string { return []string{strings.ToUpper(req.Method)} }), nil
+	case "headerFilter":
+		return f.MatchHeader(req.Header), nil
+	case "popFilter":
+		return f.Match(strings.ToLower(req.POP)), nil
+	case "regionFilter":
+		return f.Match(strings.ToLower(req.Region)), nil
+	}
+	return f.Match(""), nil
+}
+
+// policyFilters return filter fields of policy struct, json name => expression
+func policyFilters(policy reflect.Value) [][2]string {
+	res := [][2]string{}
+	t := policy.Type()
+	for i := 0; i < t.NumField(); i++ {
+		name := strings.Split(t.Field(i).Tag.Get("json"), ",")[0]
+		for _, k := range requestFilterFields {
+			if name == k {
+				res = append(res, [2]string{k, policy.Field(i).String()})
+			}
+		}
+	}
+	return res
+}
+
+// ExplainPolicy evaluate every request filter of policy, policy must be pointer of policy struct
+func ExplainPolicy(policy interface{}, req *PolicyRequest) (bool, []*FilterResult) {
+	v := reflect.ValueOf(policy)
+	if v.Kind() == reflect.Ptr {
+		v = v.Elem()
+	}
+	results := []*FilterResult{}
+	matched := true
+	for _, kv := range policyFilters(v) {
+		m, e := EvaluateFilter(kv[0], kv[1], req)
+		results = append(results, &FilterResult{Field: kv[0], Expr: kv[1], Matched: m, Err: e})
+		matched = matched && m && e == nil
+	}
+	return matched && policyEnabledValue(v, true), results
+}
+
+// PolicyApplies check whether policy is enabled and all filters match request
+func PolicyApplies(policy interface{}, req *PolicyRequest) bool {
+	m, _ := ExplainPolicy(policy, req)
+	return m
+}
+
+// PolicyMatch explain result of single policy instance
+type PolicyMatch struct {
+	// Name policy name used in configuration JSON
+	Name string
+	// Index of groupable policy, 0 for non-groupable
+	Index   int
+	Policy  interface{}
+	Enabled bool
+	Matched bool
+	Filters []*FilterResult
+}
+
+// ExplainPolicies evaluate every policy in configuration against request, ordered as doc and list order
+func (c *Configuration) ExplainPolicies(req *PolicyRequest) []*PolicyMatch {
+	res := []*PolicyMatch{}
+	for _, pi := range policyInstances(c) {
+		m, fr := ExplainPolicy(pi.Value.Interface(), req)
+		res = append(res, &PolicyMatch{
+			Name:    pi.Name,
+			Index:   pi.Index,
+			Policy:  pi.Value.Interface(),
+			Enabled: policyEnabledValue(pi.Value.Elem(), true),
+			Matched: m,
+			Filters: fr,
+		})
+	}
+	return res
+}
+
+// MatchPolicies return policies applied to request
+func (c *Configuration) MatchPolicies(req *PolicyRequest) []*PolicyMatch {
+	res := []*PolicyMatch{}
+	for _, m := range c.ExplainPolicies(req) {
+		if m.Matched {
+			res = append(res, m)
+		}
+	}
+	return res
+}
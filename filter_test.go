@@ -0,0 +1,89 @@
+package hwapi_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/bucloud/hwapi"
+)
+
+func TestEvaluateFilter(t *testing.T) {
+	cases := []struct {
+		kind   string
+		expr   string
+		method string
+		url    string
+		header http.Header
+		pop    string
+		want   bool
+	}{
+		{"pathFilter", "*", "GET", "http://a.com/x/y/z.html", nil, "", true},
+		{"pathFilter", "", "GET", "http://a.com/x.html", nil, "", true},
+		{"pathFilter", "wildcard:/DIR/*.html", "GET", "http://a.com/DIR/FOO/index.html", nil, "", true},
+		{"pathFilter", "glob:/DIR/*.html", "GET", "http://a.com/DIR/FOO/index.html", nil, "", false},
+		{"pathFilter", "glob:/DIR/*.html", "GET", "http://a.com/DIR/index.html", nil, "", true},
+		{"pathFilter", "glob:DIR/*.html", "GET", "http://a.com/DIR/index.html", nil, "", true},
+		{"pathFilter", `regex:/.*DIR/\d/.*file.txt/`, "GET", "http://a.com/x/DIR/1/a/file.txt", nil, "", true},
+		{"pathFilter", `regex:/.*DIR/\d/.*file.txt/,/EXP/`, "GET", "http://a.com/x/DIR/a/file.txt", nil, "", false},
+		{"pathFilter", `regex:/.*DIR/\d/.*file.txt/,/EXP/`, "GET", "http://a.com/foo/EXP/bar", nil, "", true},
+		{"pathFilter", `regex:/^\/a{1,3}$/, /^\/b\//`, "GET", "http://a.com/aa", nil, "", true},
+		{"pathFilter", `regex:/^\/a{1,3}$/, /^\/b\//`, "GET", "http://a.com/b/c", nil, "", true},
+		{"pathFilter", `regex:/^\/a{1,3}$/, /^\/b\//`, "GET", "http://a.com/c/b/", nil, "", false},
+		{"pathFilter", `regex:/^\/a$/, glob:/b/*`, "GET", "http://a.com/b/c", nil, "", true},
+		{"pathFilter", `glob:/a/*, /b/*`, "GET", "http://a.com/b/c/d", nil, "", false},
+		{"pathFilter", `wildcard:/a/*, /b/*`, "GET", "http://a.com/b/c/d", nil, "", true},
+		{"pathFilter", "!*.mp4,!*.ts", "GET", "http://a.com/v/1.ts", nil, "", false},
+		{"pathFilter", "!*.mp4,!*.ts", "GET", "http://a.com/v/1.m3u8", nil, "", true},
+		{"pathFilter", "wildcard:https://*.a.com/*", "GET", "https://img.a.com/x/1.png", nil, "", true},
+		{"pathFilter", "wildcard:https://*.a.com/*", "GET", "http://img.a.com/x/1.png", nil, "", false},
+		{"pathFilter", "glob:http*://b.com/*.png", "GET", "https://B.com/1.png", nil, "", true},
+		{"methodFilter", "GET,HEAD", "head", "http://a.com/", nil, "", true},
+		{"methodFilter", "!POST", "POST", "http://a.com/", nil, "", false},
+		{"headerFilter", "wildcard: User-Agent: Mozilla*", "GET", "http://a.com/", http.Header{"User-Agent": {"Mozilla/Firefox 6.0"}}, "", true},
+		{"headerFilter", "glob:User-Agent: Mozilla*", "GET", "http://a.com/", http.Header{"User-Agent": {"Mozilla/Firefox 6.0"}}, "", false},
+		{"headerFilter", "glob:user-agent: Mozilla*", "GET", "http://a.com/", http.Header{"User-Agent": {"Mozilla 6.0"}}, "", true},
+		{"headerFilter", "regex:/User-Agent:.*(iphone|android).*/", "GET", "http://a.com/", http.Header{"User-Agent": {"x android y"}}, "", true},
+		{"popFilter", "lax,sjc", "GET", "http://a.com/", nil, "LAX", true},
+		{"popFilter", "!(?i)LAX", "GET", "http://a.com/", nil, "lax", false},
+		{"popFilter", "!fra", "GET", "http://a.com/", nil, "lax", true},
+	}
+	for _, c := range cases {
+		req, err := hwapi.NewPolicyRequest(c.method, c.url, c.header, c.pop)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := hwapi.EvaluateFilter(c.kind, c.expr, req)
+		if err != nil {
+			t.Errorf("%s %q: %s", c.kind, c.expr, err.Error())
+			continue
+		}
+		if got != c.want {
+			t.Errorf("%s %q on %s: got %t, want %t", c.kind, c.expr, c.url, got, c.want)
+		}
+	}
+}
+
+func TestMatchPolicies(t *testing.T) {
+	disabled := false
+	conf := &hwapi.Configuration{
+		OriginPullPolicy: []*hwapi.OriginPullPolicy{
+			{ID: 1, PathFilter: "glob:/static/*"},
+			{ID: 2, PathFilter: "!glob:/static/*", MethodFilter: "GET"},
+			{ID: 3, Enabled: &disabled},
+		},
+		Compression: &hwapi.Compression{},
+	}
+	req, _ := hwapi.NewPolicyRequest("GET", "http://a.com/static/app.js", nil, "lax")
+	got := map[int64]bool{}
+	for _, m := range conf.MatchPolicies(req) {
+		if p, ok := m.Policy.(*hwapi.OriginPullPolicy); ok {
+			got[p.ID] = true
+		}
+	}
+	if !got[1] || got[2] || got[3] || len(got) != 1 {
+		t.Errorf("unexpected originPullPolicy matched %v", got)
+	}
+	if _, err := hwapi.ParseFilter("regex:/(/"); err == nil {
+		t.Error("invalid regex should fail")
+	}
+}
@@ -19,6 +19,7 @@ require (
 	github.com/spf13/viper v1.7.0 // indirect
 	go.mongodb.org/mongo-driver v1.3.3 // indirect
 	golang.org/x/mod v0.3.0 // indirect
+	golang.org/x/net v0.0.0-20201110031124-69a78807bb2b
 	golang.org/x/oauth2 v0.0.0-20200902213428-5d25da1a8d43
 	golang.org/x/text v0.3.3
 	google.golang.org/api v0.32.0
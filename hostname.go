@@ -0,0 +1,223 @@
+package hwapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"golang.org/x/net/idna"
+)
+
+var (
+	// ErrHostnameConflict hostname overlaps with a hostname already exists in account
+	ErrHostnameConflict = errors.New("hostname conflict")
+	// ErrHostnameInvalid hostname can't been normalized
+	ErrHostnameInvalid = errors.New("invalid hostname")
+)
+
+// Hostname conflict reasons
+const (
+	// HostnameConflictExact same hostname after normalization
+	HostnameConflictExact = "exact"
+	// HostnameConflictWildcard one of hostnames is a wildcard covering the other
+	HostnameConflictWildcard = "wildcard"
+)
+
+// HostnameConflict proposed hostname conflicts with Existing
+type HostnameConflict struct {
+	// Domain normalized proposed hostname
+	Domain   string
+	Existing *HostName
+	Reason   string
+}
+
+func (c *HostnameConflict) Error() string {
+	return fmt.Sprintf("%s conflicts with %s (%s) on host %s scope %d", c.Domain, c.Existing.Domain, c.Reason, c.Existing.HostHash, c.Existing.ScopeID)
+}
+
+// Unwrap support errors.Is(err, ErrHostnameConflict)
+func (c *HostnameConflict) Unwrap() error {
+	return ErrHostnameConflict
+}
+
+// NormalizeHostname lower case, remove trailing dot and convert IDN to punycode, leading *. is kept
+func NormalizeHostname(domain string) (string, error) {
+	d := strings.TrimSuffix(strings.TrimSpace(domain), ".")
+	wildcard := strings.HasPrefix(d, "*.")
+	if wildcard {
+		d = d[2:]
+	}
+	a, e := idna.Lookup.ToASCII(d)
+	if e != nil || a == "" || strings.Contains(a, "*") {
+		return "", fmt.Errorf("%w: %s", ErrHostnameInvalid, domain)
+	}
+	a = strings.ToLower(a)
+	if wildcard {
+		return "*." + a, nil
+	}
+	return a, nil
+}
+
+// wildcardCovers report whether wildcard *.parent matches host, wildcard matches a single label
+func wildcardCovers(wildcard string, host string) bool {
+	parent := strings.TrimPrefix(wildcard, "*")
+	if !strings.HasSuffix(host, parent) {
+		return false
+	}
+	label := strings.TrimSuffix(host, parent)
+	return label != "" && !strings.Contains(label, ".")
+}
+
+// hostnameConflictReason compare two normalized hostnames, empty means no conflict
+func hostnameConflictReason(a string, b string) string {
+	switch {
+	case a == b:
+		return HostnameConflictExact
+	case strings.HasPrefix(a, "*.") && wildcardCovers(a, strings.TrimPrefix(b, "*")):
+		return HostnameConflictWildcard
+	case strings.HasPrefix(b, "*.") && wildcardCovers(b, strings.TrimPrefix(a, "*")):
+		return HostnameConflictWildcard
+	}
+	return ""
+}
+
+// CheckHostnameConflicts compare domain with every existing hostname
+// Wildcard *.example.com covers a.example.com but not a.b.example.com.
+// Hostnames which can't been normalized are compared as lower case
+func CheckHostnameConflicts(existing []*HostName, domain string) ([]*HostnameConflict, error) {
+	d, e := NormalizeHostname(domain)
+	if e != nil {
+		return nil, e
+	}
+	res := []*HostnameConflict{}
+	for _, h := range existing {
+		n, e := NormalizeHostname(h.Domain)
+		if e != nil {
+			n = strings.ToLower(h.Domain)
+		}
+		if r := hostnameConflictReason(d, n); r != "" {
+			res = append(res, &HostnameConflict{Domain: d, Existing: h, Reason: r})
+		}
+	}
+	return res, nil
+}
+
+// CheckHostname compare domain with all hostnames of account
+func (api *HWApi) CheckHostname(accountHash string, domain string) ([]*HostnameConflict, error) {
+	l, e := api.GetHostNames(accountHash)
+	if e != nil {
+		return nil, e
+	}
+	return CheckHostnameConflicts(l.List, domain)
+}
+
+// ScopeHostnames list hostnames on scope
+func (api *HWApi) ScopeHostnames(accountHash string, hostHash string, scopeID int) ([]*HostName, error) {
+	l, e := api.GetHostNames(accountHash)
+	if e != nil {
+		return nil, e
+	}
+	res := []*HostName{}
+	for _, h := range l.List {
+		if h.HostHash == hostHash && h.ScopeID == scopeID {
+			res = append(res, h)
+		}
+	}
+	return res, nil
+}
+
+// AddHostname add hostname to scope, conflicts with account hostnames are checked before calling API
+// first conflict is returned as *HostnameConflict
+func (api *HWApi) AddHostname(ctx context.Context, accountHash string, hostHash string, scopeID int, domain string) (*Configuration, error) {
+	d, e := NormalizeHostname(domain)
+	if e != nil {
+		return nil, e
+	}
+	conflicts, e := api.CheckHostname(accountHash, d)
+	if e != nil {
+		return nil, e
+	}
+	if len(conflicts) > 0 {
+		return nil, conflicts[0]
+	}
+	return api.addHostname(ctx, accountHash, hostHash, scopeID, d)
+}
+
+func (api *HWApi) addHostname(ctx context.Context, accountHash string, hostHash string, scopeID int, domain string) (*Configuration, error) {
+	return api.updatePolicy(ctx, accountHash, hostHash, scopeID, "hostname", func(current reflect.Value) (reflect.Value, error) {
+		return reflect.Append(current, reflect.ValueOf(&Hostname{Domain: domain})), nil
+	})
+}
+
+// RemoveHostname remove hostname from scope, domain is compared after normalization
+func (api *HWApi) RemoveHostname(ctx context.Context, accountHash string, hostHash string, scopeID int, domain string) (*Configuration, error) {
+	d, e := NormalizeHostname(domain)
+	if e != nil {
+		return nil, e
+	}
+	return api.updatePolicy(ctx, accountHash, hostHash, scopeID, "hostname", func(current reflect.Value) (reflect.Value, error) {
+		list := current.Interface().([]*Hostname)
+		rest := []*Hostname{}
+		for _, h := range list {
+			if h == nil {
+				continue
+			}
+			if n, _ := NormalizeHostname(h.Domain); n != d {
+				rest = append(rest, h)
+			}
+		}
+		if len(rest) == len(list) {
+			return current, fmt.Errorf("%w: hostname %s", ErrPolicyNotFound, domain)
+		}
+		return reflect.ValueOf(rest), nil
+	})
+}
+
+// MoveHostname move hostname of account to another host scope
+// Hostname is removed from its current scope first, if adding to target fails it's added back
+// even if ctx is done, so hostname isn't lost
+func (api *HWApi) MoveHostname(ctx context.Context, accountHash string, domain string, hostHash string, scopeID int) (*Configuration, error) {
+	d, e := NormalizeHostname(domain)
+	if e != nil {
+		return nil, e
+	}
+	l, e := api.GetHostNames(accountHash)
+	if e != nil {
+		return nil, e
+	}
+	var src *HostName
+	others := []*HostName{}
+	for _, h := range l.List {
+		if n, _ := NormalizeHostname(h.Domain); n == d && src == nil {
+			src = h
+			continue
+		}
+		others = append(others, h)
+	}
+	if src == nil {
+		return nil, fmt.Errorf("%w: hostname %s", ErrPolicyNotFound, domain)
+	}
+	if src.HostHash == hostHash && src.ScopeID == scopeID {
+		return api.getConfiguration(ctx, accountHash, hostHash, scopeID)
+	}
+	conflicts, e := CheckHostnameConflicts(others, d)
+	if e != nil {
+		return nil, e
+	}
+	if len(conflicts) > 0 {
+		return nil, conflicts[0]
+	}
+	if _, e := api.RemoveHostname(ctx, accountHash, src.HostHash, src.ScopeID, d); e != nil {
+		return nil, e
+	}
+	c, e := api.addHostname(ctx, accountHash, hostHash, scopeID, d)
+	if e != nil {
+		if _, re := api.addHostname(context.Background(), accountHash, src.HostHash, src.ScopeID, d); re != nil {
+			return nil, fmt.Errorf("%w, restore to host %s scope %d failed, %s", e, src.HostHash, src.ScopeID, re.Error())
+		}
+		return nil, e
+	}
+	return c, nil
+}
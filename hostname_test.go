@@ -0,0 +1,97 @@
+package hwapi_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/bucloud/hwapi"
+)
+
+func TestNormalizeHostname(t *testing.T) {
+	for in, want := range map[string]string{
+		"WWW.Example.COM.": "www.example.com",
+		"*.Example.com":    "*.example.com",
+		"bücher.de":        "xn--bcher-kva.de",
+		"*.BÜCHER.de":      "*.xn--bcher-kva.de",
+	} {
+		if got, e := hwapi.NormalizeHostname(in); e != nil || got != want {
+			t.Errorf("NormalizeHostname(%q) = %q, %v, want %q", in, got, e, want)
+		}
+	}
+	for _, in := range []string{"", "a.*.com", "*"} {
+		if _, e := hwapi.NormalizeHostname(in); !errors.Is(e, hwapi.ErrHostnameInvalid) {
+			t.Errorf("NormalizeHostname(%q) error %v", in, e)
+		}
+	}
+}
+
+func TestCheckHostnameConflicts(t *testing.T) {
+	existing := []*hwapi.HostName{
+		{Domain: "www.example.com", HostHash: "h1"},
+		{Domain: "*.cdn.example.com", HostHash: "h2"},
+		{Domain: "xn--bcher-kva.de", HostHash: "h3"},
+	}
+	cases := []struct {
+		domain string
+		host   string
+		reason string
+	}{
+		{"WWW.example.com", "h1", hwapi.HostnameConflictExact},
+		{"img.cdn.example.com", "h2", hwapi.HostnameConflictWildcard},
+		{"*.cdn.example.com", "h2", hwapi.HostnameConflictExact},
+		{"bücher.de", "h3", hwapi.HostnameConflictExact},
+		{"*.example.com", "h1", hwapi.HostnameConflictWildcard},
+		{"a.img.cdn.example.com", "", ""},
+		{"example.com", "", ""},
+	}
+	for _, c := range cases {
+		conflicts, e := hwapi.CheckHostnameConflicts(existing, c.domain)
+		if e != nil {
+			t.Fatal(e)
+		}
+		if c.host == "" {
+			if len(conflicts) != 0 {
+				t.Errorf("%s: unexpected conflict %v", c.domain, conflicts[0])
+			}
+			continue
+		}
+		if len(conflicts) != 1 || conflicts[0].Existing.HostHash != c.host || conflicts[0].Reason != c.reason {
+			t.Errorf("%s: conflicts %v, want %s with %s", c.domain, conflicts, c.reason, c.host)
+			continue
+		}
+		if !errors.Is(conflicts[0], hwapi.ErrHostnameConflict) {
+			t.Errorf("%s: conflict is not ErrHostnameConflict", c.domain)
+		}
+	}
+}
+
+func TestMoveHostname(t *testing.T) {
+	s := &hostsServer{
+		conf: map[string]string{"src": `{"scope":{"id":1},"hostname":[{"domain":"a.example.com"},{"domain":"b.example.com"}]}`},
+		puts: map[string]string{},
+	}
+	api := newTestAPI(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/hostnames") {
+			w.Write([]byte(`{"list":[{"domain":"a.example.com","hostHash":"src","scopeId":1},{"domain":"b.example.com","hostHash":"src","scopeId":1}]}`))
+			return
+		}
+		s.ServeHTTP(w, r)
+	}))
+
+	// target host is unavailable, hostname is added back to source
+	if _, e := api.MoveHostname(context.Background(), "acc", "A.example.com", "dst", 1); e == nil {
+		t.Fatal("expect error of unavailable target")
+	}
+	if !strings.Contains(s.puts["src"], `"a.example.com"`) {
+		t.Errorf("expect hostname restored to source, sent %s", s.puts["src"])
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, e := api.AddHostname(ctx, "acc", "src", 1, "c.example.com"); !errors.Is(e, context.Canceled) {
+		t.Errorf("expect context.Canceled, got %v", e)
+	}
+}
@@ -2,6 +2,7 @@ package hwapi
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -21,6 +22,8 @@ type Request struct {
 	Headers map[string]string
 	Body    interface{}
 	Options map[string]string
+	// Context of http request, optional
+	Context context.Context
 }
 
 // Response simple response
@@ -115,6 +118,9 @@ func (api *HWApi) Request(req *Request) (*Response, error) {
 	if ee != nil {
 		panic(ee)
 	}
+	if req.Context != nil {
+		r = r.WithContext(req.Context)
+	}
 	r.Header = http.Header{}
 	r.Header.Set("X-Application", "GO-HWApi")
 	r.Header.Set("X-Application-Id", "GO-HWApi")
@@ -156,7 +162,7 @@ func (api *HWApi) Fetch(req *http.Request) (*Response, error) {
 	defer func() {
 		rep.Body.Close()
 		if api.Log != nil {
-			api.Log.Debug().Str("request", req.URL.String()).Interface("headers", req.Header).Str("remote_address", req.RemoteAddr).Int("status_code", rep.StatusCode).Int64("size", req.ContentLength).Dur("spent", time.Since(startRequest))
+			api.Log.Debug().Str("request", redactRawURL(req.URL)).Interface("headers", redactHeader(req.Header)).Str("remote_address", req.RemoteAddr).Int("status_code", rep.StatusCode).Int64("size", req.ContentLength).Dur("spent", time.Since(startRequest)).Msg("request")
 		}
 	}()
 
@@ -0,0 +1,71 @@
+package hwapi_test
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/VictoriaMetrics/fastcache"
+	"github.com/bucloud/hwapi"
+)
+
+// newTestAPI HWApi sending every request to handler instead of striketracker
+func newTestAPI(t *testing.T, h http.Handler, options ...interface{}) *hwapi.HWApi {
+	ts := httptest.NewTLSServer(h)
+	t.Cleanup(ts.Close)
+	tr := &http.Transport{
+		DialContext: func(ctx context.Context, network, addr string) (net.Conn, error) {
+			return (&net.Dialer{}).DialContext(ctx, network, ts.Listener.Addr().String())
+		},
+		TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+	}
+	return hwapi.Init(append([]interface{}{tr, fastcache.New(1 << 20), &hwapi.AuthToken{AccessToken: "token", TokenType: "bearer"}}, options...)...)
+}
+
+// scopeServer scope configuration served by GET, every GET returns next of gets once.
+// PUT is merged per policy type into the configuration returned by last GET, null or [] removes the type,
+// types absent from body are kept. Merged configuration with id receipt-N is served afterwards.
+type scopeServer struct {
+	sync.Mutex
+	gets []string
+	puts []string
+}
+
+func (s *scopeServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.Lock()
+	defer s.Unlock()
+	if r.Method != http.MethodPut {
+		c := s.gets[0]
+		if len(s.gets) > 1 {
+			s.gets = s.gets[1:]
+		}
+		w.Write([]byte(c))
+		return
+	}
+	b, _ := ioutil.ReadAll(r.Body)
+	s.puts = append(s.puts, string(b))
+	current, body := map[string]json.RawMessage{}, map[string]json.RawMessage{}
+	json.Unmarshal([]byte(s.gets[0]), &current)
+	if e := json.Unmarshal(b, &body); e != nil {
+		http.Error(w, `{"error":"invalid body"}`, http.StatusBadRequest)
+		return
+	}
+	for k, v := range body {
+		if string(v) == "null" || string(v) == "[]" {
+			delete(current, k)
+		} else {
+			current[k] = v
+		}
+	}
+	current["id"] = json.RawMessage(fmt.Sprintf(`"receipt-%d"`, len(s.puts)))
+	b, _ = json.Marshal(current)
+	s.gets = []string{string(b)}
+	w.Write(b)
+}
@@ -19,8 +19,11 @@ type HWApi struct {
 	remoteS3       map[string]*aws.Config
 	CurrentUser    *User
 	cache          *fastcache.Cache
+	snapshots      SnapshotStore
 	workers        int
 	Log            *zerolog.Logger
+	// MarshalMode used by UpdateConfiguration, default MarshalDefaulted
+	MarshalMode MarshalMode
 }
 
 var (
@@ -33,7 +36,7 @@ var (
 // Init HWApi
 // support several options
 //
-// *http.Transport  use custom transport instead of defaultTransport
+// *http.Transport  use custom transport instead of defaultTransport, such like one dialing a test server
 //
 // *fastcache.Cache local cache, mainly used to store downloads state
 //
@@ -44,6 +47,10 @@ var (
 // *hwapi.User  Current userinfo
 //
 // *hwapi.AuthToken  set default token
+//
+// hwapi.SnapshotStore  snapshot configuration before every update
+//
+// hwapi.MarshalMode  whether UpdateConfiguration sends defaulted fields
 func Init(options ...interface{}) *HWApi {
 	api := &HWApi{
 		hc: &http.Transport{
@@ -62,6 +69,8 @@ func Init(options ...interface{}) *HWApi {
 	}
 	for _, opt := range options {
 		switch opt.(type) {
+		case *http.Transport:
+			api.hc = opt.(*http.Transport)
 		case int:
 			api.workers = opt.(int)
 		case *fastcache.Cache:
@@ -72,6 +81,10 @@ func Init(options ...interface{}) *HWApi {
 			api.AuthToken = opt.(*AuthToken)
 		case *zerolog.Logger:
 			api.Log = opt.(*zerolog.Logger)
+		case SnapshotStore:
+			api.snapshots = opt.(SnapshotStore)
+		case MarshalMode:
+			api.MarshalMode = opt.(MarshalMode)
 		case *LocalCacheConfig:
 			cc := opt.(*LocalCacheConfig)
 			if cc.FilePath != "" {
@@ -0,0 +1,417 @@
+package hwapi
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Lint severities
+const (
+	SeverityError   = "error"
+	SeverityWarning = "warning"
+	SeverityInfo    = "info"
+)
+
+// LintFinding single problem found in configuration
+type LintFinding struct {
+	// RuleID stable ID of rule, such like HW001
+	RuleID   string
+	Severity string
+	// Policy name used in configuration JSON, empty for configuration level findings
+	Policy string
+	// Index of groupable policy
+	Index int
+	// Field JSON name of policy field, could been empty
+	Field   string
+	Message string
+}
+
+func (f *LintFinding) String() string {
+	at := f.Policy
+	if at != "" {
+		at = fmt.Sprintf("%s[%d]", f.Policy, f.Index)
+		if f.Field != "" {
+			at += "." + f.Field
+		}
+		at += " "
+	}
+	return fmt.Sprintf("%s %s %s%s", f.Severity, f.RuleID, at, f.Message)
+}
+
+// LintRule pluggable lint rule, Check should only return findings with RuleID&Severity of this rule
+type LintRule struct {
+	ID          string
+	Severity    string
+	Description string
+	Check       func(r *LintRule, c *Configuration) []*LintFinding
+}
+
+// finding create finding of this rule
+func (r *LintRule) finding(pi *policyInstance, field string, format string, args ...interface{}) *LintFinding {
+	f := &LintFinding{RuleID: r.ID, Severity: r.Severity, Field: field, Message: fmt.Sprintf(format, args...)}
+	if pi != nil {
+		f.Policy, f.Index = pi.Name, pi.Index
+	}
+	return f
+}
+
+// DefaultLintRules built-in rules used by Lint when no rules provided, append your own rules if needed
+var DefaultLintRules = []*LintRule{
+	{ID: "HW001", Severity: SeverityError, Description: "filter expression can't been parsed", Check: lintInvalidFilter},
+	{ID: "HW002", Severity: SeverityWarning, Description: "include and exclude patterns mixed in the same filter list", Check: lintMixedFilter},
+	{ID: "HW003", Severity: SeverityWarning, Description: "headerFilter might not work for originPullPolicy/originRequestQueue/originResponseQueue on cacheable assets", Check: lintOriginHeaderFilter},
+	{ID: "HW004", Severity: SeverityWarning, Description: "both popFilter and regionFilter narrowed on the same policy", Check: lintPopRegionFilter},
+	{ID: "HW005", Severity: SeverityError, Description: "midTierCaching enabled without popFilter", Check: lintMidTierPopFilter},
+	{ID: "HW006", Severity: SeverityWarning, Description: "waf enabled by positive path filter", Check: lintWafFilter},
+	{ID: "HW007", Severity: SeverityWarning, Description: "duplicate policies", Check: lintDuplicatePolicy},
+	{ID: "HW008", Severity: SeverityWarning, Description: "conflicting policies with identical filters", Check: lintConflictingPolicy},
+	{ID: "HW009", Severity: SeverityWarning, Description: "policy shadowed by an earlier policy", Check: lintShadowedPolicy},
+	{ID: "HW010", Severity: SeverityError, Description: "access log IP obfuscation disabled", Check: lintIPObfuscation},
+	{ID: "HW011", Severity: SeverityWarning, Description: "delivery receipt certificate not verified", Check: lintReceiptCertificate},
+	{ID: "HW012", Severity: SeverityWarning, Description: "signing secret missed or too short", Check: lintWeakSecret},
+	{ID: "HW013", Severity: SeverityInfo, Description: "origin pulled over plain http", Check: lintOriginProtocol},
+}
+
+// firstMatchPolicies groupable policies documented to be applied by the first match only
+var firstMatchPolicies = map[string]bool{
+	"awsSignedS3PostV4":     true,
+	"awsSignedOriginPullV4": true,
+}
+
+// Lint check configuration with rules, DefaultLintRules used if no rules provided
+// findings are ordered by severity(error first) then rule ID
+func (c *Configuration) Lint(rules ...*LintRule) []*LintFinding {
+	if len(rules) == 0 {
+		rules = DefaultLintRules
+	}
+	res := []*LintFinding{}
+	for _, r := range rules {
+		res = append(res, r.Check(r, c)...)
+	}
+	rank := map[string]int{SeverityError: 0, SeverityWarning: 1, SeverityInfo: 2}
+	sort.SliceStable(res, func(i, j int) bool {
+		if rank[res[i].Severity] != rank[res[j].Severity] {
+			return rank[res[i].Severity] < rank[res[j].Severity]
+		}
+		return res[i].RuleID < res[j].RuleID
+	})
+	return res
+}
+
+// filterFields return every *Filter list field of policy, json name => expression
+func filterFields(v reflect.Value) [][2]string {
+	res := [][2]string{}
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		name := strings.Split(t.Field(i).Tag.Get("json"), ",")[0]
+		if list := t.Field(i).Tag.Get("list"); (list == "GLOB" || list == "IGLOB") && strings.HasSuffix(name, "Filter") {
+			res = append(res, [2]string{name, v.Field(i).String()})
+		}
+	}
+	return res
+}
+
+// normalizeFilter empty filter equals to "*"
+func normalizeFilter(expr string) string {
+	if e := strings.TrimSpace(expr); e != "" {
+		return e
+	}
+	return "*"
+}
+
+func lintInvalidFilter(r *LintRule, c *Configuration) []*LintFinding {
+	res := []*LintFinding{}
+	for _, pi := range policyInstances(c) {
+		for _, kv := range filterFields(pi.Value.Elem()) {
+			if _, e := ParseFilter(kv[1]); e != nil {
+				res = append(res, r.finding(pi, kv[0], "%s", e.Error()))
+			}
+		}
+	}
+	return res
+}
+
+func lintMixedFilter(r *LintRule, c *Configuration) []*LintFinding {
+	res := []*LintFinding{}
+	for _, pi := range policyInstances(c) {
+		for _, kv := range filterFields(pi.Value.Elem()) {
+			if f, e := ParseFilter(kv[1]); e == nil && f.Mixed() {
+				res = append(res, r.finding(pi, kv[0], "%q mixes include and exclude patterns, exclude patterns always win", kv[1]))
+			}
+		}
+	}
+	return res
+}
+
+func lintOriginHeaderFilter(r *LintRule, c *Configuration) []*LintFinding {
+	res := []*LintFinding{}
+	dynamicByHeader := false
+	for _, d := range c.DynamicContent {
+		if d != nil && d.HeaderFields != "" {
+			dynamicByHeader = true
+		}
+	}
+	for _, pi := range policyInstances(c) {
+		switch pi.Name {
+		case "originPullPolicy", "originRequestQueue", "originResponseQueue":
+		default:
+			continue
+		}
+		if h := pi.Value.Elem().FieldByName("HeaderFilter").String(); normalizeFilter(h) != "*" && !dynamicByHeader {
+			res = append(res, r.finding(pi, "headerFilter", "headerFilter %q only works for non-cacheable assets or dynamic cache based on header", h))
+		}
+	}
+	return res
+}
+
+func lintPopRegionFilter(r *LintRule, c *Configuration) []*LintFinding {
+	res := []*LintFinding{}
+	for _, pi := range policyInstances(c) {
+		v := pi.Value.Elem()
+		pop, region := v.FieldByName("PopFilter"), v.FieldByName("RegionFilter")
+		if !pop.IsValid() || !region.IsValid() {
+			continue
+		}
+		if normalizeFilter(pop.String()) != "*" && normalizeFilter(region.String()) != "*" {
+			res = append(res, r.finding(pi, "regionFilter", "popFilter %q and regionFilter %q are both required to match, use only one of them", pop.String(), region.String()))
+		}
+	}
+	return res
+}
+
+func lintMidTierPopFilter(r *LintRule, c *Configuration) []*LintFinding {
+	res := []*LintFinding{}
+	for _, pi := range policyInstances(c) {
+		if pi.Name != "midTierCaching" || !policyEnabledValue(pi.Value.Elem(), false) {
+			continue
+		}
+		if normalizeFilter(pi.Value.Elem().FieldByName("PopFilter").String()) == "*" {
+			res = append(res, r.finding(pi, "popFilter", "popFilter has to be set when pulling content from a sister pop"))
+		}
+	}
+	return res
+}
+
+func lintWafFilter(r *LintRule, c *Configuration) []*LintFinding {
+	res := []*LintFinding{}
+	for _, pi := range policyInstances(c) {
+		if pi.Name != "waf" || !policyEnabledValue(pi.Value.Elem(), false) {
+			continue
+		}
+		f, e := ParseFilter(pi.Value.Elem().FieldByName("PathFilter").String())
+		if e != nil {
+			continue
+		}
+		for _, p := range f.Patterns {
+			if !p.Exclude && p.Expr != "*" {
+				res = append(res, r.finding(pi, "pathFilter", "only negative patterns should be used to enable waf, %q may disable waf for /sbbi/", p.Raw))
+				break
+			}
+		}
+	}
+	return res
+}
+
+// policySettings JSON of policy without ID/comment/filters
+func policySettings(v reflect.Value) string {
+	t := v.Type()
+	b := &strings.Builder{}
+	for i := 0; i < t.NumField(); i++ {
+		name := strings.Split(t.Field(i).Tag.Get("json"), ",")[0]
+		if t.Field(i).PkgPath != "" || name == "-" || name == "id" || name == "comment" || strings.HasSuffix(name, "Filter") {
+			continue
+		}
+		f := v.Field(i)
+		if f.Kind() == reflect.Ptr {
+			if f.IsNil() {
+				continue
+			}
+			f = f.Elem()
+		}
+		fmt.Fprintf(b, "%s=%v;", name, f.Interface())
+	}
+	return b.String()
+}
+
+// policyFilterKey normalized filters of policy
+func policyFilterKey(v reflect.Value) map[string]string {
+	res := map[string]string{}
+	for _, kv := range filterFields(v) {
+		res[kv[0]] = normalizeFilter(kv[1])
+	}
+	return res
+}
+
+// groupedInstances enabled instances grouped by policy name
+func groupedInstances(c *Configuration) map[string][]*policyInstance {
+	res := map[string][]*policyInstance{}
+	for _, pi := range policyInstances(c) {
+		if pi.Groupable && policyEnabledValue(pi.Value.Elem(), true) {
+			res[pi.Name] = append(res[pi.Name], pi)
+		}
+	}
+	return res
+}
+
+func lintDuplicatePolicy(r *LintRule, c *Configuration) []*LintFinding {
+	res := []*LintFinding{}
+	groups := groupedInstances(c)
+	for _, name := range sortedKeys(groups) {
+		list := groups[name]
+		for j := 1; j < len(list); j++ {
+			for i := 0; i < j; i++ {
+				a, b := list[i].Value.Elem(), list[j].Value.Elem()
+				if reflect.DeepEqual(policyFilterKey(a), policyFilterKey(b)) && policySettings(a) == policySettings(b) {
+					res = append(res, r.finding(list[j], "", "duplicate of %s[%d]", name, list[i].Index))
+					break
+				}
+			}
+		}
+	}
+	return res
+}
+
+func lintConflictingPolicy(r *LintRule, c *Configuration) []*LintFinding {
+	res := []*LintFinding{}
+	groups := groupedInstances(c)
+	for _, name := range sortedKeys(groups) {
+		list := groups[name]
+		for j := 1; j < len(list); j++ {
+			for i := 0; i < j; i++ {
+				a, b := list[i].Value.Elem(), list[j].Value.Elem()
+				if reflect.DeepEqual(policyFilterKey(a), policyFilterKey(b)) && policySettings(a) != policySettings(b) {
+					res = append(res, r.finding(list[j], "", "same filters as %s[%d] but different settings", name, list[i].Index))
+					break
+				}
+			}
+		}
+	}
+	return res
+}
+
+// filtersCover every request matched by b is also matched by a
+// only trivial cases are detected, "*" or identical expression
+func filtersCover(a, b map[string]string) bool {
+	for k, av := range a {
+		if av != "*" && av != b[k] {
+			return false
+		}
+	}
+	return true
+}
+
+func lintShadowedPolicy(r *LintRule, c *Configuration) []*LintFinding {
+	res := []*LintFinding{}
+	groups := groupedInstances(c)
+	for _, name := range sortedKeys(groups) {
+		if !firstMatchPolicies[name] {
+			continue
+		}
+		list := groups[name]
+		for j := 1; j < len(list); j++ {
+			for i := 0; i < j; i++ {
+				a, b := policyFilterKey(list[i].Value.Elem()), policyFilterKey(list[j].Value.Elem())
+				if filtersCover(a, b) && !reflect.DeepEqual(a, b) {
+					res = append(res, r.finding(list[j], "", "never applied, %s[%d] matches every request it matches", name, list[i].Index))
+					break
+				}
+			}
+		}
+	}
+	return res
+}
+
+func lintIPObfuscation(r *LintRule, c *Configuration) []*LintFinding {
+	if c.AccessLogIPObfuscation != nil && c.AccessLogIPObfuscation.Enabled != nil && !*c.AccessLogIPObfuscation.Enabled {
+		return []*LintFinding{r.finding(&policyInstance{PolicyType: PolicyByName("accessLogIpObfuscation")}, "enabled", "client IPs are logged without obfuscation, which breaks GDPR compliance")}
+	}
+	return nil
+}
+
+func lintReceiptCertificate(r *LintRule, c *Configuration) []*LintFinding {
+	res := []*LintFinding{}
+	for _, pi := range policyInstances(c) {
+		if pi.Name != "requestReceipt" {
+			continue
+		}
+		rr := pi.Value.Interface().(*RequestReceipt)
+		if rr.VerifyCertificate != nil && !*rr.VerifyCertificate && strings.HasPrefix(strings.ToLower(rr.URIFormat), "https://") {
+			res = append(res, r.finding(pi, "verifyCertificate", "receipt origin certificate is not verified"))
+		}
+	}
+	return res
+}
+
+// minSecretLength secrets shorter than this are reported
+const minSecretLength = 12
+
+// secretValues split sensitive field into secrets, list and hashMap fields contain several ones
+func secretValues(sf reflect.StructField, s string) []string {
+	switch {
+	case sf.Tag.Get("hashMap") != "":
+		if entries, e := ParseHashMap(s); e == nil {
+			res := []string{}
+			for _, kv := range entries {
+				res = append(res, kv.Value)
+			}
+			return res
+		}
+	case sf.Tag.Get("list") != "":
+		res := []string{}
+		for _, v := range strings.Split(s, ",") {
+			res = append(res, strings.TrimSpace(v))
+		}
+		return res
+	}
+	return []string{s}
+}
+
+func lintWeakSecret(r *LintRule, c *Configuration) []*LintFinding {
+	res := []*LintFinding{}
+	for _, pi := range policyInstances(c) {
+		if !policyEnabledValue(pi.Value.Elem(), true) {
+			continue
+		}
+		v := pi.Value.Elem()
+		for i := 0; i < v.NumField(); i++ {
+			sf := v.Type().Field(i)
+			if sf.Tag.Get("sensitive") != "true" || sf.Type.Kind() != reflect.String {
+				continue
+			}
+			tag := sf.Tag.Get("json")
+			name, s := strings.Split(tag, ",")[0], v.Field(i).String()
+			if s == "" {
+				// optional secrets such like salt of authUrlSignAKv2 may be empty
+				if strings.HasSuffix(tag, ",") {
+					res = append(res, r.finding(pi, name, "secret is empty"))
+				}
+				continue
+			}
+			for _, secret := range secretValues(sf, s) {
+				if len(secret) < minSecretLength {
+					res = append(res, r.finding(pi, name, "secret shorter than %d characters", minSecretLength))
+					break
+				}
+			}
+		}
+	}
+	return res
+}
+
+func lintOriginProtocol(r *LintRule, c *Configuration) []*LintFinding {
+	if c.OriginPullProtocol != nil && strings.EqualFold(c.OriginPullProtocol.Protocol, "http") {
+		return []*LintFinding{r.finding(&policyInstance{PolicyType: PolicyByName("originPullProtocol")}, "protocol", "origin requests are sent without TLS, even for https client requests")}
+	}
+	return nil
+}
+
+func sortedKeys(m map[string][]*policyInstance) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
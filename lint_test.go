@@ -0,0 +1,88 @@
+package hwapi_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/bucloud/hwapi"
+)
+
+func lintRule(id string) *hwapi.LintRule {
+	for _, r := range hwapi.DefaultLintRules {
+		if r.ID == id {
+			return r
+		}
+	}
+	return nil
+}
+
+func TestLintRules(t *testing.T) {
+	cases := []struct {
+		rule  string
+		bad   string
+		field string
+		good  string
+	}{
+		{"HW001", `{"originPullPolicy":[{"pathFilter":"regex:/(/"}]}`, "pathFilter", `{"originPullPolicy":[{"pathFilter":"regex:/a/"}]}`},
+		{"HW002", `{"originPullPolicy":[{"pathFilter":"*.mp4,!*.ts"}]}`, "pathFilter", `{"originPullPolicy":[{"pathFilter":"!*.mp4,!*.ts"}]}`},
+		{"HW003", `{"originPullPolicy":[{"headerFilter":"X-Device: mobile"}]}`, "headerFilter", `{"originPullPolicy":[{"headerFilter":"X-Device: mobile"}],"dynamicContent":[{"headerFields":"X-Device"}]}`},
+		{"HW004", `{"midTierCaching":[{"popFilter":"sjc","regionFilter":"US"}]}`, "regionFilter", `{"midTierCaching":[{"popFilter":"sjc"}]}`},
+		{"HW005", `{"midTierCaching":[{"enabled":true}]}`, "popFilter", `{"midTierCaching":[{"enabled":true,"popFilter":"sjc"}]}`},
+		{"HW006", `{"waf":[{"enabled":true,"pathFilter":"/app/*"}]}`, "pathFilter", `{"waf":[{"enabled":true,"pathFilter":"!/static/*"}]}`},
+		{"HW007", `{"originPullPolicy":[{"expireSeconds":5},{"expireSeconds":5}]}`, "", `{"originPullPolicy":[{"expireSeconds":5,"pathFilter":"/a/*"},{"expireSeconds":5,"pathFilter":"/b/*"}]}`},
+		{"HW008", `{"originPullPolicy":[{"expireSeconds":5},{"expireSeconds":6}]}`, "", `{"originPullPolicy":[{"expireSeconds":5,"pathFilter":"/a/*"},{"expireSeconds":6,"pathFilter":"/b/*"}]}`},
+		{"HW009", `{"awsSignedOriginPullV4":[{"enabled":true,"accessKeyId":"a"},{"enabled":true,"accessKeyId":"b","pathFilter":"/a/*"}]}`, "", `{"awsSignedOriginPullV4":[{"enabled":true,"accessKeyId":"b","pathFilter":"/a/*"},{"enabled":true,"accessKeyId":"a"}]}`},
+		// only awsSigned policies are documented first match
+		{"HW009", `{"awsSignedS3PostV4":[{"enabled":true,"accessKeyId":"a","popFilter":"sjc"},{"enabled":true,"accessKeyId":"b","popFilter":"sjc","pathFilter":"/a/*"}]}`, "", `{"originPullPolicy":[{"expireSeconds":5},{"expireSeconds":6,"pathFilter":"/a/*"}]}`},
+		{"HW010", `{"accessLogIpObfuscation":{"enabled":false}}`, "enabled", `{"accessLogIpObfuscation":{"enabled":true}}`},
+		{"HW011", `{"requestReceipt":[{"uriFormat":"https://receipt.example.com/","verifyCertificate":false}]}`, "verifyCertificate", `{"requestReceipt":[{"uriFormat":"https://receipt.example.com/","verifyCertificate":true}]}`},
+		{"HW012", `{"authUrlSignL3":[{"tokenField":"t","sharedSecretTable":"long-enough-secret,short"}]}`, "sharedSecretTable", `{"authUrlSignL3":[{"tokenField":"t","sharedSecretTable":"long-enough-secret,another-long-one"}]}`},
+		{"HW012", `{"authUrlSignAKv2":[{"passPhrase":"long-enough-secret","salt":"short"}]}`, "salt", `{"authUrlSignAKv2":[{"passPhrase":"long-enough-secret"}]}`},
+		{"HW012", `{"authUrlSignHmacTlu":[{"symmetricKeyIdMap":"1:long-enough-secret,2:short"}]}`, "symmetricKeyIdMap", `{"authUrlSignHmacTlu":[{"symmetricKeyIdMap":"1:long-enough-secret"}]}`},
+		{"HW013", `{"originPullProtocol":{"protocol":"http"}}`, "protocol", `{"originPullProtocol":{"protocol":"https"}}`},
+	}
+	covered := map[string]bool{}
+	for _, c := range cases {
+		r := lintRule(c.rule)
+		if r == nil {
+			t.Errorf("%s: rule missed", c.rule)
+			continue
+		}
+		covered[c.rule] = true
+		for _, fixture := range []string{c.bad, c.good} {
+			conf := &hwapi.Configuration{}
+			if e := json.Unmarshal([]byte(fixture), conf); e != nil {
+				t.Fatal(e)
+			}
+			findings := conf.Lint(r)
+			if fixture == c.good {
+				if len(findings) != 0 {
+					t.Errorf("%s: unexpected finding %s", c.rule, findings[0])
+				}
+				continue
+			}
+			if len(findings) == 0 {
+				t.Errorf("%s: no finding for %s", c.rule, fixture)
+				continue
+			}
+			if f := findings[0]; f.RuleID != r.ID || f.Severity != r.Severity || f.Field != c.field {
+				t.Errorf("%s: got %s field %q", c.rule, f, f.Field)
+			}
+		}
+	}
+	if len(covered) != len(hwapi.DefaultLintRules) {
+		t.Errorf("%d of %d rules covered", len(covered), len(hwapi.DefaultLintRules))
+	}
+}
+
+func TestLintOrder(t *testing.T) {
+	conf := &hwapi.Configuration{}
+	json.Unmarshal([]byte(`{"originPullProtocol":{"protocol":"http"},"originPullPolicy":[{"expireSeconds":5},{"expireSeconds":5}],"accessLogIpObfuscation":{"enabled":false}}`), conf)
+	findings := conf.Lint()
+	if len(findings) < 3 || findings[0].RuleID != "HW010" || findings[len(findings)-1].RuleID != "HW013" {
+		t.Errorf("findings not ordered by severity: %v", findings)
+	}
+	if f := findings[len(findings)-1]; f.String() != "info HW013 originPullProtocol[0].protocol origin requests are sent without TLS, even for https client requests" {
+		t.Errorf("got %s", f)
+	}
+}
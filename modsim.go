@@ -0,0 +1,394 @@
+package hwapi
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// MatchRegexGlobal regex pattern of modification policies which replaces every match
+const MatchRegexGlobal = "regexglobal"
+
+// AddHeaders modes of modification policies
+const (
+	AddHeaderAppend  = "append"
+	AddHeaderReplace = "replace"
+	AddHeaderCreate  = "create"
+)
+
+// ModificationRequest client request and origin response evaluated by SimulateModification
+type ModificationRequest struct {
+	*PolicyRequest
+	// Status response code of origin, default 200
+	Status int
+	// ResponseHeader headers of origin response
+	ResponseHeader http.Header
+	// Vars values of variables used in rewrites and addHeaders, such like client.ip for %client.ip%
+	Vars map[string]string
+}
+
+// ModificationStep modification policy which fired
+type ModificationStep struct {
+	// Policy such like clientRequestModification
+	Policy string
+	Index  int
+	// Changes what the policy changed
+	Changes []string
+	// Break flowControl stopped processing of following policies
+	Break bool
+}
+
+// ModificationResult request and response after modification policies
+type ModificationResult struct {
+	// ClientRequest request after clientRequestModification, used by other policies
+	ClientRequest *PolicyRequest
+	// OriginRequest request sent to origin after originRequestModification
+	OriginRequest *PolicyRequest
+	// OriginStatus and OriginHeader origin response after originResponseModification
+	OriginStatus int
+	OriginHeader http.Header
+	// Status and Header response sent to client after clientResponseModification
+	Status int
+	Header http.Header
+	// Steps fired policies in order
+	Steps []*ModificationStep
+	// Trace explain how policies were evaluated
+	Trace []string
+}
+
+func (r *ModificationResult) tracef(format string, args ...interface{}) {
+	r.Trace = append(r.Trace, fmt.Sprintf(format, args...))
+}
+
+// modificationRule fields shared by request/response modification policies
+type modificationRule struct {
+	policy        interface{}
+	flowControl   string
+	urlPattern    string
+	urlRewrite    string
+	headerPattern string
+	headerRewrite string
+	addHeaders    string
+	status        uint32
+}
+
+// modificationRules read rules from slice of modification policies, missing fields are empty
+func modificationRules(list interface{}) []*modificationRule {
+	res := []*modificationRule{}
+	lv := reflect.ValueOf(list)
+	for i := 0; i < lv.Len(); i++ {
+		p := lv.Index(i)
+		if p.IsNil() {
+			res = append(res, nil)
+			continue
+		}
+		v := p.Elem()
+		str := func(name string) string {
+			if f := v.FieldByName(name); f.IsValid() {
+				return f.String()
+			}
+			return ""
+		}
+		r := &modificationRule{policy: p.Interface(), flowControl: str("FlowControl"), urlPattern: str("URLPattern"), urlRewrite: str("URLRewrite"),
+			headerPattern: str("HeaderPattern"), headerRewrite: str("HeaderRewrite"), addHeaders: str("AddHeaders")}
+		if f := v.FieldByName("StatusCodeRewrite"); f.IsValid() {
+			r.status = uint32(f.Uint())
+		}
+		res = append(res, r)
+	}
+	return res
+}
+
+// rewritePattern urlPattern or headerPattern
+type rewritePattern struct {
+	*FilterPattern
+	global bool
+}
+
+func parseRewritePattern(raw string) (*rewritePattern, error) {
+	s := strings.TrimSpace(raw)
+	if strings.HasPrefix(s, "!") {
+		return nil, fmt.Errorf("exclude pattern %q isn't supported", raw)
+	}
+	global := false
+	if strings.HasPrefix(strings.ToLower(s), MatchRegexGlobal+":") {
+		global, s = true, MatchRegex+":"+s[len(MatchRegexGlobal)+1:]
+	}
+	p, e := ParseFilterPattern(s)
+	if e != nil {
+		return nil, e
+	}
+	return &rewritePattern{FilterPattern: p, global: global}, nil
+}
+
+// rewrite apply pattern to subject, ok is false if pattern doesn't match
+// regex replaces first match and regexglobal every match, $1 or \1 refer to capture group,
+// wildcard and glob replace whole subject
+func (p *rewritePattern) rewrite(subject string, replacement string) (string, bool) {
+	if p.Type != MatchRegex {
+		if !p.re.MatchString(subject) {
+			return subject, false
+		}
+		return replacement, true
+	}
+	tmpl := backrefPattern.ReplaceAllString(replacement, "$${$1}")
+	if p.global {
+		if !p.re.MatchString(subject) {
+			return subject, false
+		}
+		return p.re.ReplaceAllString(subject, tmpl), true
+	}
+	m := p.re.FindStringSubmatchIndex(subject)
+	if m == nil {
+		return subject, false
+	}
+	dst := p.re.ExpandString(nil, tmpl, subject, m)
+	return subject[:m[0]] + string(dst) + subject[m[1]:], true
+}
+
+var (
+	backrefPattern  = regexp.MustCompile(`\\(\d)`)
+	variablePattern = regexp.MustCompile(`%([a-zA-Z0-9_.-]+)%`)
+	addHeaderStart  = regexp.MustCompile("^\\s*((append|replace|create)\\s+)?([!#$%&'*+.^_`|~0-9A-Za-z-]+)\\s*:")
+	numericName     = regexp.MustCompile(`^[0-9]+$`)
+)
+
+// expandVariables replace %name% by vars, unknown variables are kept
+func expandVariables(s string, vars map[string]string) string {
+	return variablePattern.ReplaceAllStringFunc(s, func(m string) string {
+		if v, ok := vars[m[1:len(m)-1]]; ok {
+			return v
+		}
+		return m
+	})
+}
+
+// addHeader single element of addHeaders, "[append|replace|create ]Name: value"
+type addHeader struct {
+	mode  string
+	name  string
+	value string
+}
+
+// isAddHeaderStart whether p starts a new header, [mode ]Name: where Name isn't numeric such like 13 of 12:00, 13:00
+func isAddHeaderStart(p string) bool {
+	m := addHeaderStart.FindStringSubmatch(p)
+	return m != nil && !numericName.MatchString(m[3])
+}
+
+// parseAddHeaders split addHeaders list, comma inside header value is kept
+func parseAddHeaders(s string) ([]*addHeader, error) {
+	parts := []string{}
+	for _, p := range strings.Split(s, ",") {
+		if len(parts) > 0 && !isAddHeaderStart(p) {
+			parts[len(parts)-1] += "," + p
+			continue
+		}
+		parts = append(parts, p)
+	}
+	res := []*addHeader{}
+	for _, p := range parts {
+		if p = strings.TrimSpace(p); p == "" {
+			continue
+		}
+		i := strings.Index(p, ":")
+		if i < 0 {
+			return nil, fmt.Errorf("invalid header %q", p)
+		}
+		h := &addHeader{mode: AddHeaderAppend, name: strings.TrimSpace(p[:i]), value: strings.TrimSpace(p[i+1:])}
+		if f := strings.Fields(h.name); len(f) == 2 {
+			h.mode, h.name = f[0], f[1]
+		}
+		res = append(res, h)
+	}
+	return res, nil
+}
+
+// modifyHeader apply headerPattern/headerRewrite and addHeaders, return changes
+// headerPattern is matched against each "Name: value" line, empty rewrite removes the header
+func modifyHeader(h http.Header, r *modificationRule, vars map[string]string) ([]string, error) {
+	changes := []string{}
+	if r.headerPattern != "" {
+		p, e := parseRewritePattern(r.headerPattern)
+		if e != nil {
+			return nil, fmt.Errorf("headerPattern: %w", e)
+		}
+		names := []string{}
+		for k := range h {
+			names = append(names, k)
+		}
+		sort.Strings(names)
+		result := http.Header{}
+		for _, k := range names {
+			for _, v := range h[k] {
+				line := k + ": " + v
+				n, ok := p.rewrite(line, expandVariables(r.headerRewrite, vars))
+				if !ok {
+					result.Add(k, v)
+					continue
+				}
+				if n = strings.TrimSpace(n); n == "" {
+					changes = append(changes, "remove header "+line)
+					continue
+				}
+				i := strings.Index(n, ":")
+				if i <= 0 {
+					return nil, fmt.Errorf("headerRewrite: %q isn't a header", n)
+				}
+				result.Add(strings.TrimSpace(n[:i]), strings.TrimSpace(n[i+1:]))
+				changes = append(changes, fmt.Sprintf("rewrite header %q to %q", line, n))
+			}
+		}
+		for k := range h {
+			delete(h, k)
+		}
+		for k, v := range result {
+			h[k] = v
+		}
+	}
+	adds, e := parseAddHeaders(r.addHeaders)
+	if e != nil {
+		return nil, fmt.Errorf("addHeaders: %w", e)
+	}
+	for _, a := range adds {
+		v := expandVariables(a.value, vars)
+		_, exists := h[http.CanonicalHeaderKey(a.name)]
+		switch a.mode {
+		case AddHeaderReplace:
+			if !exists {
+				continue
+			}
+			h.Set(a.name, v)
+		case AddHeaderCreate:
+			if exists {
+				continue
+			}
+			h.Set(a.name, v)
+		case AddHeaderAppend:
+			h.Add(a.name, v)
+		default:
+			return nil, fmt.Errorf("addHeaders: unknown mode %q", a.mode)
+		}
+		changes = append(changes, fmt.Sprintf("%s header %s: %s", a.mode, a.name, v))
+	}
+	return changes, nil
+}
+
+// modifyURL apply urlPattern/urlRewrite, pattern is matched against path?query, or full URL for URL patterns
+func modifyURL(u *url.URL, r *modificationRule, vars map[string]string) (*url.URL, []string, error) {
+	if r.urlPattern == "" {
+		return u, nil, nil
+	}
+	p, e := parseRewritePattern(r.urlPattern)
+	if e != nil {
+		return nil, nil, fmt.Errorf("urlPattern: %w", e)
+	}
+	subject := u.RequestURI()
+	if p.URL {
+		subject = u.Scheme + "://" + u.Host + subject
+	}
+	n, ok := p.rewrite(subject, expandVariables(r.urlRewrite, vars))
+	if !ok || n == subject {
+		return u, nil, nil
+	}
+	nu, e := u.Parse(n)
+	if e != nil {
+		return nil, nil, fmt.Errorf("urlRewrite: %w", e)
+	}
+	return nu, []string{fmt.Sprintf("rewrite URL %s to %s", subject, nu.String())}, nil
+}
+
+// applyModifications run rules of one policy in order, req is used to evaluate filters
+// u and h are modified, status is nil for request phases
+func (res *ModificationResult) applyModifications(name string, list interface{}, req *PolicyRequest, u **url.URL, h http.Header, status *int, vars map[string]string) error {
+	for i, r := range modificationRules(list) {
+		if r == nil {
+			continue
+		}
+		if m, filters := ExplainPolicy(r.policy, req); !m {
+			res.tracef("%s[%d]: disabled or filters don't match %s", name, i, filterResultsString(filters))
+			continue
+		}
+		step := &ModificationStep{Policy: name, Index: i, Changes: []string{}}
+		if u != nil {
+			nu, changes, e := modifyURL(*u, r, vars)
+			if e != nil {
+				return fmt.Errorf("%s[%d]: %w", name, i, e)
+			}
+			*u = nu
+			step.Changes = append(step.Changes, changes...)
+		}
+		changes, e := modifyHeader(h, r, vars)
+		if e != nil {
+			return fmt.Errorf("%s[%d]: %w", name, i, e)
+		}
+		step.Changes = append(step.Changes, changes...)
+		if status != nil && r.status != 0 && int(r.status) != *status {
+			step.Changes = append(step.Changes, fmt.Sprintf("rewrite status %d to %d", *status, r.status))
+			*status = int(r.status)
+		}
+		if len(step.Changes) == 0 {
+			res.tracef("%s[%d]: patterns don't match", name, i)
+			continue
+		}
+		step.Break = strings.EqualFold(r.flowControl, "break")
+		res.Steps = append(res.Steps, step)
+		res.tracef("%s[%d]: %s", name, i, strings.Join(step.Changes, "; "))
+		if step.Break {
+			res.tracef("%s[%d]: flowControl break", name, i)
+			return nil
+		}
+	}
+	return nil
+}
+
+// SimulateModification apply clientRequestModification, originRequestModification, originResponseModification
+// and clientResponseModification of configuration, usually an EffectiveConfiguration, to request and origin response.
+//
+// Policies of each kind are applied in order, filters are evaluated against the request as modified so far.
+// A policy fires if it changed URL, headers or status, flowControl break of fired policy stops the rest of same kind.
+//
+// urlPattern and headerPattern support wildcard:, glob:, regex: and regexglobal: prefixes, wildcard and glob
+// replace whole URL or header line, regex replaces first match and regexglobal every match, $1 or \1 refer to group.
+// URL is path?query unless pattern is an URL pattern. Header line is "Name: value", empty headerRewrite removes it.
+// addHeaders elements are "[append|replace|create ]Name: value", %name% is replaced by Vars.
+func (c *Configuration) SimulateModification(req *ModificationRequest) (*ModificationResult, error) {
+	status := req.Status
+	if status == 0 {
+		status = http.StatusOK
+	}
+	res := &ModificationResult{Steps: []*ModificationStep{}, Trace: []string{}}
+
+	client := &PolicyRequest{Method: req.Method, URL: req.URL, Header: req.Header.Clone(), POP: req.POP, Region: req.Region}
+	if client.Header == nil {
+		client.Header = http.Header{}
+	}
+	if e := res.applyModifications("clientRequestModification", c.ClientRequestModification, client, &client.URL, client.Header, nil, req.Vars); e != nil {
+		return nil, e
+	}
+	res.ClientRequest = client
+
+	origin := &PolicyRequest{Method: client.Method, URL: client.URL, Header: client.Header.Clone(), POP: client.POP, Region: client.Region}
+	if e := res.applyModifications("originRequestModification", c.OriginRequestModification, origin, &origin.URL, origin.Header, nil, req.Vars); e != nil {
+		return nil, e
+	}
+	res.OriginRequest = origin
+
+	res.OriginStatus, res.OriginHeader = status, req.ResponseHeader.Clone()
+	if res.OriginHeader == nil {
+		res.OriginHeader = http.Header{}
+	}
+	if e := res.applyModifications("originResponseModification", c.OriginResponseModification, client, nil, res.OriginHeader, &res.OriginStatus, req.Vars); e != nil {
+		return nil, e
+	}
+
+	res.Status, res.Header = res.OriginStatus, res.OriginHeader.Clone()
+	if e := res.applyModifications("clientResponseModification", c.ClientResponseModification, client, nil, res.Header, &res.Status, req.Vars); e != nil {
+		return nil, e
+	}
+	return res, nil
+}
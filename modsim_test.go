@@ -0,0 +1,68 @@
+package hwapi_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/bucloud/hwapi"
+)
+
+func TestSimulateModification(t *testing.T) {
+	c := &hwapi.Configuration{}
+	if e := json.Unmarshal([]byte(`{
+		"clientRequestModification":[
+			{"urlPattern":"regex:/^\\/old\\/(.*)$/","urlRewrite":"/new/$1","flowControl":"break"},
+			{"urlPattern":"regex:/new/","urlRewrite":"newer"},
+			{"pathFilter":"/new/*","addHeaders":"X-Client: %client.ip%, X-List: a, b"}
+		],
+		"originRequestModification":[
+			{"headerPattern":"regex:/^Cookie: .*/","headerRewrite":""},
+			{"headerPattern":"wildcard:User-Agent: *","headerRewrite":"User-Agent: cdn"}
+		],
+		"originResponseModification":[
+			{"statusCodeRewrite":200,"headerPattern":"regexglobal:/secret/","headerRewrite":"***"}
+		],
+		"clientResponseModification":[
+			{"pathFilter":"/old/*","addHeaders":"X-Old: 1"},
+			{"addHeaders":"create Cache-Control: max-age=60, public, X-Time: 12:00, 13:00, replace X-Missing: 1","flowControl":"break"},
+			{"addHeaders":"X-After: 1"}
+		]
+	}`), c); e != nil {
+		t.Fatal(e)
+	}
+	pr, _ := hwapi.NewPolicyRequest("GET", "http://www.example.com/old/a.html?x=1", http.Header{"Cookie": {"a=1"}, "User-Agent": {"curl"}}, "")
+	r, e := c.SimulateModification(&hwapi.ModificationRequest{
+		PolicyRequest:  pr,
+		Status:         404,
+		ResponseHeader: http.Header{"X-Info": {"secret-secret"}},
+		Vars:           map[string]string{"client.ip": "10.0.0.1"},
+	})
+	if e != nil {
+		t.Fatal(e)
+	}
+	if u := r.ClientRequest.URL.String(); u != "http://www.example.com/new/a.html?x=1" {
+		t.Errorf("client URL %s", u)
+	}
+	if r.ClientRequest.Header.Get("X-Client") != "" {
+		t.Errorf("break should stop following rules")
+	}
+	if h := r.OriginRequest.Header; h.Get("Cookie") != "" || h.Get("User-Agent") != "cdn" {
+		t.Errorf("origin header %v", h)
+	}
+	if pr.Header.Get("Cookie") == "" {
+		t.Errorf("input request modified")
+	}
+	if r.OriginStatus != 200 || r.OriginHeader.Get("X-Info") != "***-***" {
+		t.Errorf("origin response %d %v", r.OriginStatus, r.OriginHeader)
+	}
+	if h := r.Header; h.Get("X-Old") != "" || h.Get("Cache-Control") != "max-age=60, public" || h.Get("X-Time") != "12:00, 13:00" || h.Get("X-Missing") != "" || h.Get("X-After") != "" {
+		t.Errorf("client response %v", h)
+	}
+	if len(r.Steps) != 5 {
+		for _, s := range r.Steps {
+			t.Log(s.Policy, s.Index, s.Changes)
+		}
+		t.Errorf("expect 5 steps, got %d", len(r.Steps))
+	}
+}
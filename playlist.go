@@ -0,0 +1,219 @@
+package hwapi
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// ErrPlaylistInvalid content isn't an HLS playlist
+var ErrPlaylistInvalid = errors.New("invalid playlist")
+
+// playlistURIAttr URI attribute of tags such like EXT-X-KEY, EXT-X-MAP and EXT-X-MEDIA
+var playlistURIAttr = regexp.MustCompile(`URI="([^"]*)"`)
+
+// PlaylistSigner sign URIs inside HLS playlists as authSignUrlsInPlaylist does, using URL signing policy of host
+type PlaylistSigner struct {
+	Policy *AuthSignUrlsInPlaylist
+	Signer Signer
+	// Options passed to Signer for every URI
+	Options *SignOptions
+	// Now time of playlist request, base of extendTTL, default time.Now()
+	Now time.Time
+
+	// filenames parsed filenamePatterns
+	filenames *FilterList
+}
+
+// SignedPlaylist result of PlaylistSigner
+type SignedPlaylist struct {
+	Playlist []byte
+	// Master playlist contains variant streams
+	Master bool
+	// Signed number of URIs signed
+	Signed int
+	// Cookie token sent with Set-Cookie instead of signing URIs, only with useCookie and AKv2 signer
+	Cookie *http.Cookie
+}
+
+// NewPlaylistSigner create playlist signer of authSignUrlsInPlaylist policy using signer of host URL signing policy
+func NewPlaylistSigner(p *AuthSignUrlsInPlaylist, s Signer) (*PlaylistSigner, error) {
+	switch {
+	case p == nil:
+		return nil, fmt.Errorf("%w: authSignUrlsInPlaylist is nil", ErrSignerPolicy)
+	case s == nil:
+		return nil, fmt.Errorf("%w: URL signer is required", ErrSignerPolicy)
+	}
+	f, e := ParseFilter(p.FilenamePatterns)
+	if e != nil {
+		return nil, fmt.Errorf("%w: authSignUrlsInPlaylist filenamePatterns, %s", ErrSignerPolicy, e.Error())
+	}
+	return &PlaylistSigner{Policy: p, Signer: s, filenames: f}, nil
+}
+
+func (ps *PlaylistSigner) options() *SignOptions {
+	opt := &SignOptions{}
+	if ps.Options != nil {
+		*opt = *ps.Options
+	}
+	if _, ok := ps.Signer.(*AKv2Signer); ok && ps.Policy.ExtendTTL > 0 {
+		now := ps.Now
+		if now.IsZero() {
+			now = time.Now()
+		}
+		opt.Expires = now.Add(time.Duration(ps.Policy.ExtendTTL) * time.Second)
+	}
+	return opt
+}
+
+// matches URI file name matches filenamePatterns parsed by NewPlaylistSigner, empty patterns match every file
+func (ps *PlaylistSigner) matches(u *url.URL) bool {
+	f := ps.filenames
+	if f == nil {
+		// signer not created by NewPlaylistSigner
+		var e error
+		if f, e = ParseFilter(ps.Policy.FilenamePatterns); e != nil {
+			return false
+		}
+	}
+	return f.Match(path.Base(u.Path))
+}
+
+// signURI sign URI resolved against playlist URL, relative URIs are written as absolute path
+func (ps *PlaylistSigner) signURI(base *url.URL, uri string, opt *SignOptions) (string, bool, error) {
+	ref, e := url.Parse(uri)
+	if e != nil {
+		return "", false, fmt.Errorf("%w: URI %q, %s", ErrPlaylistInvalid, uri, e.Error())
+	}
+	abs := base.ResolveReference(ref)
+	if !ps.matches(abs) {
+		return uri, false, nil
+	}
+	var signed string
+	if s, ok := ps.Signer.(*AKv2Signer); ok && ps.Policy.CookieName != "" {
+		// cookie isn't enabled, whole token stored in single query string parameter named cookieName
+		t, e := s.Token(&AKToken{URL: abs.EscapedPath(), ACL: []string{abs.EscapedPath()}, IP: opt.IP, Start: opt.Start, Expires: opt.Expires})
+		if e != nil {
+			return "", false, e
+		}
+		abs.RawQuery = joinRawQuery(append(withoutParams(parseRawQuery(abs.RawQuery), ps.Policy.CookieName), newQueryParam(ps.Policy.CookieName, t)))
+		signed = abs.String()
+	} else if signed, e = ps.Signer.Sign(abs.String(), opt); e != nil {
+		return "", false, e
+	}
+	if ref.IsAbs() {
+		return signed, true, nil
+	}
+	su, e := url.Parse(signed)
+	if e != nil {
+		return "", false, e
+	}
+	su.Scheme, su.Host, su.User = "", "", nil
+	return su.String(), true, nil
+}
+
+// cookie token covering directory of playlist, used with useCookie and AKv2 signer
+func (ps *PlaylistSigner) cookie(base *url.URL, opt *SignOptions) (*http.Cookie, error) {
+	s := ps.Signer.(*AKv2Signer)
+	dir := path.Dir(base.EscapedPath())
+	acl := strings.TrimSuffix(dir, "/") + "/*"
+	t, e := s.Token(&AKToken{URL: acl, ACL: []string{acl}, IP: opt.IP, Start: opt.Start, Expires: opt.Expires})
+	if e != nil {
+		return nil, e
+	}
+	name := stringDefault(ps.Policy.CookieName, s.TokenNames()[0])
+	return &http.Cookie{Name: name, Value: t, Path: dir, Expires: opt.Expires}, nil
+}
+
+// Sign rewrite master or media playlist requested by playlistURL, URIs matching filenamePatterns are signed,
+// including URI attributes of tags, every other line is kept as is.
+// With useCookie and AKv2 signer URIs are kept and a cookie covering the playlist directory is returned,
+// Without useCookie, AKv2 token of every URI is stored in query string parameter cookieName if it's set.
+// useCookie, cookieName and extendTTL are ignored by other signers
+func (ps *PlaylistSigner) Sign(playlistURL string, r io.Reader) (*SignedPlaylist, error) {
+	base, e := url.Parse(playlistURL)
+	if e != nil {
+		return nil, e
+	}
+	opt := ps.options()
+	res := &SignedPlaylist{}
+	_, akv2 := ps.Signer.(*AKv2Signer)
+	useCookie := akv2 && boolValue(ps.Policy.UseCookie)
+	if useCookie {
+		if res.Cookie, e = ps.cookie(base, opt); e != nil {
+			return nil, e
+		}
+	}
+
+	out := &bytes.Buffer{}
+	br := bufio.NewReader(r)
+	first := true
+	for {
+		line, re := br.ReadString('\n')
+		if re != nil && re != io.EOF {
+			return nil, re
+		}
+		if line == "" && re == io.EOF {
+			break
+		}
+		body := strings.TrimRight(line, "\r\n")
+		eol := line[len(body):]
+		if first {
+			if strings.TrimPrefix(strings.TrimSpace(body), "\ufeff") != "#EXTM3U" {
+				return nil, fmt.Errorf("%w: missing #EXTM3U", ErrPlaylistInvalid)
+			}
+			first = false
+		}
+		trimmed := strings.TrimSpace(body)
+		switch {
+		case trimmed == "" || useCookie:
+		case strings.HasPrefix(trimmed, "#EXT"):
+			var ferr error
+			body = playlistURIAttr.ReplaceAllStringFunc(body, func(m string) string {
+				uri := playlistURIAttr.FindStringSubmatch(m)[1]
+				s, signed, e := ps.signURI(base, uri, opt)
+				if e != nil {
+					ferr = e
+					return m
+				}
+				if signed {
+					res.Signed++
+				}
+				return `URI="` + s + `"`
+			})
+			if ferr != nil {
+				return nil, ferr
+			}
+		case strings.HasPrefix(trimmed, "#"):
+		default:
+			s, signed, e := ps.signURI(base, trimmed, opt)
+			if e != nil {
+				return nil, e
+			}
+			if signed {
+				res.Signed++
+				body = s
+			}
+		}
+		if strings.HasPrefix(trimmed, "#EXT-X-STREAM-INF") {
+			res.Master = true
+		}
+		out.WriteString(body + eol)
+		if re == io.EOF {
+			break
+		}
+	}
+	if first {
+		return nil, fmt.Errorf("%w: empty playlist", ErrPlaylistInvalid)
+	}
+	res.Playlist = out.Bytes()
+	return res, nil
+}
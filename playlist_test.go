@@ -0,0 +1,96 @@
+package hwapi_test
+
+import (
+	"errors"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bucloud/hwapi"
+)
+
+const testMediaPlaylist = "#EXTM3U\r\n#EXT-X-VERSION:7\r\n#EXT-X-KEY:METHOD=AES-128,URI=\"key.bin\"\r\n#EXT-X-MAP:URI=\"init.mp4\"\r\n# comment\r\n#EXTINF:6.0,\r\nseg1.ts\r\n\r\n#EXTINF:6.0,\r\nhttp://other.example.com/v/seg2.ts?x=1\r\n#EXT-X-ENDLIST\r\n"
+
+func TestPlaylistSigner(t *testing.T) {
+	us, _ := hwapi.NewAuthURLSigner(&hwapi.AuthURLSign{TokenField: "token", PassPhraseField: "secret", PassPhrase: "pass", ExpiresField: "e"})
+	exp := time.Unix(1577836800, 0)
+	ps, e := hwapi.NewPlaylistSigner(&hwapi.AuthSignUrlsInPlaylist{FilenamePatterns: "*.ts,*.mp4"}, us)
+	if e != nil {
+		t.Fatal(e)
+	}
+	ps.Options = &hwapi.SignOptions{Expires: exp}
+	r, e := ps.Sign("http://cdn.example.com/v/index.m3u8?token=x", strings.NewReader(testMediaPlaylist))
+	if e != nil {
+		t.Fatal(e)
+	}
+	lines := strings.Split(string(r.Playlist), "\r\n")
+	if r.Signed != 3 || r.Master || len(lines) != 12 {
+		t.Fatalf("unexpected result %d %t\n%s", r.Signed, r.Master, r.Playlist)
+	}
+	if lines[2] != `#EXT-X-KEY:METHOD=AES-128,URI="key.bin"` || lines[4] != "# comment" || lines[7] != "" {
+		t.Errorf("unmatched lines changed\n%s", r.Playlist)
+	}
+	for _, c := range []struct {
+		line   int
+		prefix string
+		full   string
+	}{{3, `#EXT-X-MAP:URI="/v/init.mp4?e=1577836800&token=`, "http://cdn.example.com/v/init.mp4?"}, {6, "/v/seg1.ts?e=1577836800&token=", "http://cdn.example.com"}, {9, "http://other.example.com/v/seg2.ts?x=1&e=1577836800&token=", ""}} {
+		l := lines[c.line]
+		if !strings.HasPrefix(l, c.prefix) {
+			t.Errorf("line %d: %s", c.line, l)
+			continue
+		}
+		u := strings.TrimSuffix(strings.TrimPrefix(l, "#EXT-X-MAP:URI=\""), "\"")
+		if !strings.HasPrefix(u, "http") {
+			u = "http://cdn.example.com" + u
+		}
+		if e := us.Verify(u, &hwapi.VerifyRequest{Now: exp}); e != nil {
+			t.Errorf("line %d: %v", c.line, e)
+		}
+	}
+
+	if _, e := ps.Sign("http://cdn.example.com/v/index.m3u8", strings.NewReader("seg1.ts\n")); !errors.Is(e, hwapi.ErrPlaylistInvalid) {
+		t.Errorf("expect invalid playlist, got %v", e)
+	}
+}
+
+func TestPlaylistSignerAKv2(t *testing.T) {
+	ak, _ := hwapi.NewAKv2Signer(&hwapi.AuthURLSignAKv2{PassPhrase: "abcdef0123456789", MatchURL: new(bool), TokenField: "hdntl,hdnts"})
+	now := time.Unix(1577836800, 0)
+	master := "#EXTM3U\n#EXT-X-STREAM-INF:BANDWIDTH=1280000\nlow/index.m3u8\n#EXT-X-MEDIA:TYPE=AUDIO,GROUP-ID=\"a\",URI=\"audio/index.m3u8\""
+	useCookie := true
+	ps, _ := hwapi.NewPlaylistSigner(&hwapi.AuthSignUrlsInPlaylist{UseCookie: &useCookie, CookieName: "hdnts", ExtendTTL: 60}, ak)
+	ps.Now = now
+	r, e := ps.Sign("http://cdn.example.com/v/master.m3u8", strings.NewReader(master))
+	if e != nil {
+		t.Fatal(e)
+	}
+	if string(r.Playlist) != master || !r.Master || r.Signed != 0 || r.Cookie == nil || r.Cookie.Name != "hdnts" || r.Cookie.Path != "/v" {
+		t.Fatalf("unexpected result %+v\n%s", r, r.Playlist)
+	}
+	if !r.Cookie.Expires.Equal(now.Add(time.Minute)) || !strings.Contains(r.Cookie.Value, "acl=/v/*") {
+		t.Errorf("unexpected cookie %v", r.Cookie)
+	}
+	if e := ak.Verify("http://cdn.example.com/v/low/seg1.ts", &hwapi.VerifyRequest{Now: now, Header: map[string][]string{"Cookie": {r.Cookie.String()}}}); e != nil {
+		t.Error(e)
+	}
+
+	useCookie = false
+	r, e = ps.Sign("http://cdn.example.com/v/master.m3u8", strings.NewReader(master))
+	if e != nil || r.Signed != 2 {
+		t.Fatalf("unexpected result %v\n%s", e, r.Playlist)
+	}
+	// cookie isn't enabled, whole token is stored in query string parameter named cookieName
+	l, e := url.Parse("http://cdn.example.com" + strings.Split(string(r.Playlist), "\n")[2])
+	if e != nil || l.Path != "/v/low/index.m3u8" {
+		t.Fatalf("got %v %v", l, e)
+	}
+	tok, _ := ak.Token(&hwapi.AKToken{URL: "/v/low/index.m3u8", ACL: []string{"/v/low/index.m3u8"}, Expires: now.Add(time.Minute)})
+	if q := l.Query(); len(q) != 1 || q.Get("hdnts") != tok {
+		t.Errorf("got query %v, want hdnts=%s", q, tok)
+	}
+	if e := ak.Verify(l.String(), &hwapi.VerifyRequest{Now: now}); e != nil {
+		t.Error(e)
+	}
+}
@@ -0,0 +1,264 @@
+package hwapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+var (
+	// PolicyUpdateRetries max attempts of read-modify-write before ErrPolicyConflict returned
+	PolicyUpdateRetries = 3
+
+	// ErrPolicyUnknown policy type not listed in configuration doc
+	ErrPolicyUnknown = errors.New("unknown policy type")
+	// ErrPolicyNotFound no policy with given ID exists in scope
+	ErrPolicyNotFound = errors.New("policy not found")
+	// ErrPolicyConflict policy was changed by others during every attempts
+	ErrPolicyConflict = errors.New("policy changed concurrently, retries exhausted")
+)
+
+// policyValue return Configuration field of policy type, *T or []*T
+func policyValue(c *Configuration, p *PolicyType) reflect.Value {
+	return reflect.ValueOf(c).Elem().FieldByName(p.Field)
+}
+
+// policyInstance single policy of Configuration
+type policyInstance struct {
+	*PolicyType
+	// Index of groupable policy, 0 for non-groupable
+	Index int
+	// Value pointer of policy struct
+	Value reflect.Value
+}
+
+// policyInstances list every non-nil policy in configuration, ordered as doc and list order
+func policyInstances(c *Configuration) []*policyInstance {
+	res := []*policyInstance{}
+	cv := reflect.ValueOf(c).Elem()
+	for _, p := range policies {
+		fv := cv.FieldByName(p.Field)
+		if !p.Groupable {
+			if !fv.IsNil() {
+				res = append(res, &policyInstance{PolicyType: p, Value: fv})
+			}
+			continue
+		}
+		for i := 0; i < fv.Len(); i++ {
+			if !fv.Index(i).IsNil() {
+				res = append(res, &policyInstance{PolicyType: p, Index: i, Value: fv.Index(i)})
+			}
+		}
+	}
+	return res
+}
+
+// policyEnabledValue read Enabled field, def returned if policy doesn't contain Enabled or it's nil
+func policyEnabledValue(v reflect.Value, def bool) bool {
+	f := v.FieldByName("Enabled")
+	if !f.IsValid() || f.IsNil() {
+		return def
+	}
+	return f.Elem().Bool()
+}
+
+// clonePolicyValue copy *T or []*T, policy structs are copied so callback could modify them freely,
+// Extra and defaulted fields are kept so MarshalExplicit still works on the copy.
+// Maps and pointer fields are copied too, the copy shares nothing with v
+func clonePolicyValue(v reflect.Value) reflect.Value {
+	if v.IsNil() {
+		return reflect.Zero(v.Type())
+	}
+	if v.Kind() == reflect.Ptr {
+		n := reflect.New(v.Type().Elem())
+		n.Elem().Set(v.Elem())
+		if t, ok := n.Interface().(presenceTracker); ok {
+			t.cloneMeta()
+		}
+		for i := 0; i < n.Elem().NumField(); i++ {
+			f := n.Elem().Field(i)
+			if !f.CanSet() || (f.Kind() != reflect.Map && f.Kind() != reflect.Ptr) || f.IsNil() {
+				continue
+			}
+			switch f.Kind() {
+			case reflect.Map:
+				m := reflect.MakeMapWithSize(f.Type(), f.Len())
+				for _, k := range f.MapKeys() {
+					m.SetMapIndex(k, f.MapIndex(k))
+				}
+				f.Set(m)
+			case reflect.Ptr:
+				p := reflect.New(f.Type().Elem())
+				p.Elem().Set(f.Elem())
+				f.Set(p)
+			}
+		}
+		return n
+	}
+	n := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+	for i := 0; i < v.Len(); i++ {
+		if !v.Index(i).IsNil() {
+			n.Index(i).Set(clonePolicyValue(v.Index(i)))
+		}
+	}
+	return n
+}
+
+// policyBody request body updating only policy name to value, encoded in MarshalMode of api
+func (api *HWApi) policyBody(p *PolicyType, value reflect.Value) (json.RawMessage, error) {
+	conf := &Configuration{}
+	if value.IsValid() && !value.IsNil() {
+		policyValue(conf, p).Set(value)
+	}
+	b, e := conf.MarshalWith(api.MarshalMode)
+	if e != nil {
+		return nil, e
+	}
+	m := map[string]json.RawMessage{}
+	if e := json.Unmarshal(b, &m); e != nil {
+		return nil, e
+	}
+	raw, ok := m[p.Name]
+	switch {
+	case ok:
+	case p.Groupable && value.IsValid() && !value.IsNil():
+		// empty list is dropped by omitempty
+		raw = json.RawMessage("[]")
+	default:
+		raw = json.RawMessage("null")
+	}
+	return json.Marshal(map[string]json.RawMessage{p.Name: raw})
+}
+
+// updatePolicy read-modify-write of a single policy type
+// update receive a copy of current value (*T or []*T, could been nil) and return the new one,
+// only this policy type is sent to API, other types in scope are untouched.
+// Before writing, scope is read again, if policy has been changed by others update would be retried with latest value.
+// The API has no conditional update, so this only narrows the race: a change saved between the second read
+// and the PUT is still overwritten.
+func (api *HWApi) updatePolicy(ctx context.Context, accountHash string, hostHash string, scopeID int, name string, update func(current reflect.Value) (reflect.Value, error)) (*Configuration, error) {
+	p := PolicyByName(name)
+	if p == nil {
+		return nil, fmt.Errorf("%w: %s", ErrPolicyUnknown, name)
+	}
+	conf, e := api.getConfiguration(ctx, accountHash, hostHash, scopeID)
+	if e != nil {
+		return nil, e
+	}
+	for i := 0; i < PolicyUpdateRetries; i++ {
+		before, e := json.Marshal(policyValue(conf, p).Interface())
+		if e != nil {
+			return nil, e
+		}
+		value, e := update(clonePolicyValue(policyValue(conf, p)))
+		if e != nil {
+			return nil, e
+		}
+		body, e := api.policyBody(p, value)
+		if e != nil {
+			return nil, e
+		}
+		latest, e := api.getConfiguration(ctx, accountHash, hostHash, scopeID)
+		if e != nil {
+			return nil, e
+		}
+		after, e := json.Marshal(policyValue(latest, p).Interface())
+		if e != nil {
+			return nil, e
+		}
+		if !bytes.Equal(before, after) {
+			if api.Log != nil {
+				api.Log.Debug().Str("hostHash", hostHash).Int("scopeID", scopeID).Str("policy", name).Int("attempt", i+1).Msg("policy changed concurrently, retry")
+			}
+			conf = latest
+			continue
+		}
+		var snapshot *ConfigurationSnapshot
+		if api.snapshots != nil {
+			snapshot = api.newSnapshot(accountHash, hostHash, scopeID, latest)
+		}
+		r, e := api.Request(
+			&Request{
+				Method:  PUT,
+				URL:     fmt.Sprintf("/api/v1/accounts/%s/hosts/%s/configuration/%d", accountHash, hostHash, scopeID),
+				Body:    body,
+				Context: ctx,
+			},
+		)
+		if e != nil {
+			return nil, e
+		}
+		al := &Configuration{}
+		if e := json.Unmarshal(r.body, al); e != nil {
+			return al, e
+		}
+		if snapshot != nil {
+			return al, api.saveSnapshot(snapshot, al.ID)
+		}
+		return al, nil
+	}
+	return nil, fmt.Errorf("%w: %s", ErrPolicyConflict, name)
+}
+
+// UpsertPolicy add or update single policy in scope without sending whole configuration
+// policy must be pointer of policy struct, such like &OriginPullPolicy{}, it's not modified.
+// For groupable policies, policy with same ID would been replaced, zero ID means append a new one.
+// For non-groupable policies, existing one is replaced.
+// Policy is encoded in MarshalMode of api, see updatePolicy for the limits of conflict detection.
+// Usage UpsertPolicy(ctx, "a1b1c1d1", "x1y1z1", 123, &OriginPullPolicy{ID: 456, ExpireSeconds: 60})
+func (api *HWApi) UpsertPolicy(ctx context.Context, accountHash string, hostHash string, scopeID int, policy interface{}) (*Configuration, error) {
+	p := PolicyOf(policy)
+	pv := reflect.ValueOf(policy)
+	if p == nil || pv.Kind() != reflect.Ptr || pv.IsNil() {
+		return nil, fmt.Errorf("%w: %T", ErrPolicyUnknown, policy)
+	}
+	pv = clonePolicyValue(pv)
+	id := pv.Elem().FieldByName("ID").Int()
+	return api.updatePolicy(ctx, accountHash, hostHash, scopeID, p.Name, func(current reflect.Value) (reflect.Value, error) {
+		if !p.Groupable {
+			if !current.IsNil() {
+				pv.Elem().FieldByName("ID").SetInt(current.Elem().FieldByName("ID").Int())
+			}
+			return pv, nil
+		}
+		if id == 0 {
+			return reflect.Append(current, pv), nil
+		}
+		for i := 0; i < current.Len(); i++ {
+			if current.Index(i).Elem().FieldByName("ID").Int() == id {
+				current.Index(i).Set(pv)
+				return current, nil
+			}
+		}
+		return current, fmt.Errorf("%w: %s %d", ErrPolicyNotFound, p.Name, id)
+	})
+}
+
+// RemovePolicy remove single policy from scope
+// name is the policy name used in configuration JSON, such like originPullPolicy
+// id is required by groupable policies, non-groupable policy is removed entirely
+func (api *HWApi) RemovePolicy(ctx context.Context, accountHash string, hostHash string, scopeID int, name string, id int64) (*Configuration, error) {
+	p := PolicyByName(name)
+	if p == nil {
+		return nil, fmt.Errorf("%w: %s", ErrPolicyUnknown, name)
+	}
+	return api.updatePolicy(ctx, accountHash, hostHash, scopeID, name, func(current reflect.Value) (reflect.Value, error) {
+		if !p.Groupable {
+			if current.IsNil() {
+				return current, fmt.Errorf("%w: %s", ErrPolicyNotFound, name)
+			}
+			return reflect.Zero(current.Type()), nil
+		}
+		for i := 0; i < current.Len(); i++ {
+			if current.Index(i).Elem().FieldByName("ID").Int() == id {
+				rest := reflect.MakeSlice(current.Type(), 0, current.Len()-1)
+				rest = reflect.AppendSlice(rest, current.Slice(0, i))
+				return reflect.AppendSlice(rest, current.Slice(i+1, current.Len())), nil
+			}
+		}
+		return current, fmt.Errorf("%w: %s %d", ErrPolicyNotFound, name, id)
+	})
+}
@@ -0,0 +1,122 @@
+package hwapi_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/bucloud/hwapi"
+)
+
+func TestUpsertPolicy(t *testing.T) {
+	conf := `{"originPullPolicy":[{"id":1,"expireSeconds":60},{"id":2,"expireSeconds":30,"pathFilter":"*.ts"}],"authHttpBasic":{"id":7,"ttl":10},"cacheControl":[{"id":3,"maxAge":5}]}`
+	srv := &scopeServer{gets: []string{conf}}
+	api := newTestAPI(t, srv)
+	ctx := context.Background()
+
+	p := &hwapi.OriginPullPolicy{ID: 2, ExpireSeconds: 120}
+	c, e := api.UpsertPolicy(ctx, "a", "h", 1, p)
+	if e != nil {
+		t.Fatal(e)
+	}
+	sent := map[string][]map[string]interface{}{}
+	json.Unmarshal([]byte(srv.puts[0]), &sent)
+	if len(sent) != 1 || len(sent["originPullPolicy"]) != 2 || sent["originPullPolicy"][1]["expireSeconds"] != float64(120) || sent["originPullPolicy"][0]["expireSeconds"] != float64(60) {
+		t.Errorf("sent %s", srv.puts[0])
+	}
+	if len(c.OriginPullPolicy) != 2 {
+		t.Errorf("got %v", c.OriginPullPolicy)
+	}
+
+	// non-groupable keeps existing ID, caller's value is not modified
+	basic := &hwapi.AuthHTTPBasic{TTL: 60}
+	if _, e := api.UpsertPolicy(ctx, "a", "h", 1, basic); e != nil {
+		t.Fatal(e)
+	}
+	if basic.ID != 0 || !strings.Contains(srv.puts[1], `"id":7`) || !strings.Contains(srv.puts[1], `"ttl":60`) {
+		t.Errorf("caller ID %d, sent %s", basic.ID, srv.puts[1])
+	}
+
+	// zero ID appends
+	if _, e := api.UpsertPolicy(ctx, "a", "h", 1, &hwapi.OriginPullPolicy{ExpireSeconds: 5}); e != nil {
+		t.Fatal(e)
+	}
+	json.Unmarshal([]byte(srv.puts[2]), &sent)
+	if len(sent["originPullPolicy"]) != 3 {
+		t.Errorf("sent %s", srv.puts[2])
+	}
+
+	if _, e := api.UpsertPolicy(ctx, "a", "h", 1, &hwapi.OriginPullPolicy{ID: 9}); !errors.Is(e, hwapi.ErrPolicyNotFound) {
+		t.Errorf("expect ErrPolicyNotFound, got %v", e)
+	}
+	if _, e := api.UpsertPolicy(ctx, "a", "h", 1, struct{}{}); !errors.Is(e, hwapi.ErrPolicyUnknown) {
+		t.Errorf("expect ErrPolicyUnknown, got %v", e)
+	}
+
+	// MarshalExplicit omits fields filled by doc defaults of untouched policies
+	srv = &scopeServer{gets: []string{conf}}
+	api = newTestAPI(t, srv, hwapi.MarshalExplicit)
+	if _, e := api.UpsertPolicy(ctx, "a", "h", 1, &hwapi.OriginPullPolicy{ID: 1, ExpireSeconds: 90}); e != nil {
+		t.Fatal(e)
+	}
+	json.Unmarshal([]byte(srv.puts[len(srv.puts)-1]), &sent)
+	if _, ok := sent["originPullPolicy"][1]["enabled"]; ok || sent["originPullPolicy"][1]["pathFilter"] != "*.ts" {
+		t.Errorf("explicit mode sent %s", srv.puts[len(srv.puts)-1])
+	}
+}
+
+func TestRemovePolicy(t *testing.T) {
+	srv := &scopeServer{gets: []string{`{"cacheControl":[{"id":3,"maxAge":5}],"authHttpBasic":{"id":7,"ttl":10}}`}}
+	api := newTestAPI(t, srv)
+	ctx := context.Background()
+
+	if _, e := api.RemovePolicy(ctx, "a", "h", 1, "cacheControl", 3); e != nil {
+		t.Fatal(e)
+	}
+	if srv.puts[0] != `{"cacheControl":[]}` {
+		t.Errorf("sent %s", srv.puts[0])
+	}
+	if _, e := api.RemovePolicy(ctx, "a", "h", 1, "authHttpBasic", 0); e != nil {
+		t.Fatal(e)
+	}
+	if srv.puts[1] != `{"authHttpBasic":null}` {
+		t.Errorf("sent %s", srv.puts[1])
+	}
+	if _, e := api.RemovePolicy(ctx, "a", "h", 1, "cacheControl", 4); !errors.Is(e, hwapi.ErrPolicyNotFound) {
+		t.Errorf("expect ErrPolicyNotFound, got %v", e)
+	}
+	if _, e := api.RemovePolicy(ctx, "a", "h", 1, "noSuchPolicy", 0); !errors.Is(e, hwapi.ErrPolicyUnknown) {
+		t.Errorf("expect ErrPolicyUnknown, got %v", e)
+	}
+}
+
+func TestUpdatePolicyConflict(t *testing.T) {
+	// policy changed once between read and write, second attempt writes on top of the latest value
+	srv := &scopeServer{gets: []string{`{"cacheControl":[{"id":3,"maxAge":5}]}`, `{"cacheControl":[{"id":3,"maxAge":6},{"id":4,"maxAge":1}]}`}}
+	api := newTestAPI(t, srv)
+	if _, e := api.RemovePolicy(context.Background(), "a", "h", 1, "cacheControl", 3); e != nil {
+		t.Fatal(e)
+	}
+	if len(srv.puts) != 1 || !strings.Contains(srv.puts[0], `"id":4`) || strings.Contains(srv.puts[0], `"id":3`) {
+		t.Errorf("sent %v", srv.puts)
+	}
+
+	// policy changed on every read
+	gets := []string{}
+	for i := 0; i <= hwapi.PolicyUpdateRetries*2; i++ {
+		gets = append(gets, `{"cacheControl":[{"id":3,"maxAge":`+strings.Repeat("1", i+1)+`}]}`)
+	}
+	srv = &scopeServer{gets: gets}
+	api = newTestAPI(t, srv)
+	if _, e := api.UpsertPolicy(context.Background(), "a", "h", 1, &hwapi.CacheControl{ID: 3}); !errors.Is(e, hwapi.ErrPolicyConflict) || len(srv.puts) != 0 {
+		t.Errorf("expect ErrPolicyConflict without writing, got %v, %v", e, srv.puts)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, e := api.UpsertPolicy(ctx, "a", "h", 1, &hwapi.CacheControl{ID: 3}); !errors.Is(e, context.Canceled) {
+		t.Errorf("expect context.Canceled, got %v", e)
+	}
+}
@@ -0,0 +1,162 @@
+package hwapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+)
+
+// MarshalMode control whether defaulted fields are sent to API
+type MarshalMode int
+
+const (
+	// MarshalDefaulted every field including the ones filled by doc defaults, the fully defaulted view
+	MarshalDefaulted MarshalMode = iota
+	// MarshalExplicit only fields returned by API or set by user, defaulted fields which are still unchanged are omitted
+	MarshalExplicit
+)
+
+// policyMeta embedded by every policy type, track fields filled by doc defaults on decode
+type policyMeta struct {
+	// defaulted JSON name => default value
+	defaulted map[string]interface{}
+	// present JSON names of non-null fields found in decoded object, zero values included
+	present map[string]bool
+}
+
+// presenceTracker implemented by every policy type through policyMeta
+type presenceTracker interface {
+	setPresent(raw map[string]json.RawMessage)
+	presentFields() map[string]bool
+	cloneMeta()
+}
+
+func (m *policyMeta) setPresent(raw map[string]json.RawMessage) {
+	m.present = map[string]bool{}
+	for k, v := range raw {
+		if string(bytes.TrimSpace(v)) != "null" {
+			m.present[k] = true
+		}
+	}
+}
+
+func (m *policyMeta) presentFields() map[string]bool {
+	return m.present
+}
+
+// cloneMeta replace tracked maps by copies, used after policy struct is copied
+func (m *policyMeta) cloneMeta() {
+	defaulted, present := m.defaulted, m.present
+	m.defaulted, m.present = nil, nil
+	for k, v := range defaulted {
+		m.setDefaulted(k, v)
+	}
+	if present != nil {
+		m.present = make(map[string]bool, len(present))
+		for k, v := range present {
+			m.present[k] = v
+		}
+	}
+}
+
+// has report whether field was present in decoded object, generated setDefaults only fill absent fields
+func (m *policyMeta) has(field string) bool {
+	return m.present[field]
+}
+
+func (m *policyMeta) setDefaulted(field string, value interface{}) {
+	if m.defaulted == nil {
+		m.defaulted = map[string]interface{}{}
+	}
+	m.defaulted[field] = value
+}
+
+// Defaulted report whether field, the JSON name, was filled by doc default instead of returned by API
+func (m *policyMeta) Defaulted(field string) bool {
+	_, ok := m.defaulted[field]
+	return ok
+}
+
+// DefaultedFields JSON names of fields filled by doc defaults, sorted
+func (m *policyMeta) DefaultedFields() []string {
+	res := []string{}
+	for k := range m.defaulted {
+		res = append(res, k)
+	}
+	sort.Strings(res)
+	return res
+}
+
+// SetExplicit mark field as set by user, so it's sent by MarshalExplicit even if value equals to default,
+// zero value included
+func (m *policyMeta) SetExplicit(field string) {
+	delete(m.defaulted, field)
+	if m.present == nil {
+		m.present = map[string]bool{}
+	}
+	m.present[field] = true
+}
+
+// defaultedPolicy implemented by every policy type through policyMeta
+type defaultedPolicy interface {
+	defaultedValues() map[string]interface{}
+}
+
+func (m *policyMeta) defaultedValues() map[string]interface{} {
+	return m.defaulted
+}
+
+// MarshalWith encode configuration in given mode, json.Marshal uses MarshalDefaulted
+func (c *Configuration) MarshalWith(mode MarshalMode) ([]byte, error) {
+	b, e := json.Marshal(c)
+	if e != nil || mode != MarshalExplicit {
+		return b, e
+	}
+	m := map[string]json.RawMessage{}
+	if e := json.Unmarshal(b, &m); e != nil {
+		return nil, e
+	}
+	for _, pi := range policyInstances(c) {
+		dp, ok := pi.Value.Interface().(defaultedPolicy)
+		if !ok || len(dp.defaultedValues()) == 0 {
+			continue
+		}
+		var list []json.RawMessage
+		raw := m[pi.Name]
+		if pi.Groupable {
+			if e := json.Unmarshal(raw, &list); e != nil || pi.Index >= len(list) {
+				continue
+			}
+			raw = list[pi.Index]
+		}
+		if raw, e = omitDefaulted(raw, dp.defaultedValues()); e != nil {
+			return nil, e
+		}
+		if pi.Groupable {
+			list[pi.Index] = raw
+			if raw, e = json.Marshal(list); e != nil {
+				return nil, e
+			}
+		}
+		m[pi.Name] = raw
+	}
+	return json.Marshal(m)
+}
+
+// omitDefaulted remove fields of policy object which still equal to the default filled on decode
+func omitDefaulted(raw json.RawMessage, defaulted map[string]interface{}) (json.RawMessage, error) {
+	obj := map[string]json.RawMessage{}
+	if e := json.Unmarshal(raw, &obj); e != nil {
+		return nil, e
+	}
+	for k, v := range defaulted {
+		d, e := json.Marshal(v)
+		if e != nil {
+			return nil, e
+		}
+		if cur, ok := obj[k]; ok && bytes.Equal(bytes.TrimSpace(cur), d) {
+			delete(obj, k)
+		}
+	}
+	return json.Marshal(obj)
+}
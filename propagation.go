@@ -0,0 +1,154 @@
+package hwapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	// ErrPropagationStalled progress didn't change within StallTimeout
+	ErrPropagationStalled = errors.New("configuration propagation stalled")
+	// ErrPropagationTimeout progress didn't reach 100 within Timeout
+	ErrPropagationTimeout = errors.New("configuration propagation timeout")
+)
+
+// PropagationOptions control how WaitForConfiguration polls update status
+type PropagationOptions struct {
+	// Interval first polling interval, default 2s, grows 1.5x each poll
+	Interval time.Duration
+	// MaxInterval max polling interval, default 30s
+	MaxInterval time.Duration
+	// Timeout overall waiting time, default 15m
+	Timeout time.Duration
+	// StallTimeout max duration progress is allowed to stay unchanged, default 5m
+	StallTimeout time.Duration
+	// MaxErrors consecutive status API failures allowed before giving up, default 3
+	MaxErrors int
+
+	// Context used to cancel waiting, default context.Background()
+	Context context.Context
+	// OnProgress called after each successful poll
+	OnProgress func(progress float32)
+	// Progress receive every polled progress, sending never blocks, value is dropped if channel is full
+	Progress chan<- float32
+}
+
+// PropagationError returned by WaitForConfiguration
+// Err is ErrPropagationStalled, ErrPropagationTimeout, context error or the error of status API
+type PropagationError struct {
+	ReceiptID string
+	// Progress last known progress
+	Progress float32
+	// API true means status API failed, false means API works but propagation didn't finish
+	API bool
+	Err error
+}
+
+func (e *PropagationError) Error() string {
+	return fmt.Sprintf("configuration %s at %.1f%%: %s", e.ReceiptID, e.Progress, e.Err.Error())
+}
+
+// Unwrap support errors.Is(err, ErrPropagationStalled)
+func (e *PropagationError) Unwrap() error {
+	return e.Err
+}
+
+func (o *PropagationOptions) withDefault() *PropagationOptions {
+	r := PropagationOptions{}
+	if o != nil {
+		r = *o
+	}
+	if r.Interval <= 0 {
+		r.Interval = 2 * time.Second
+	}
+	if r.MaxInterval < r.Interval {
+		r.MaxInterval = 30 * time.Second
+		if r.MaxInterval < r.Interval {
+			r.MaxInterval = r.Interval
+		}
+	}
+	if r.Timeout <= 0 {
+		r.Timeout = 15 * time.Minute
+	}
+	if r.StallTimeout <= 0 {
+		r.StallTimeout = 5 * time.Minute
+	}
+	if r.MaxErrors <= 0 {
+		r.MaxErrors = 3
+	}
+	if r.Context == nil {
+		r.Context = context.Background()
+	}
+	return &r
+}
+
+// WaitForConfiguration poll CheckConfigUpdateStatus until progress reaches 100
+// receiptID is the ID of Configuration returned by UpdateConfiguration, opt could been nil
+func (api *HWApi) WaitForConfiguration(accountHash string, hostHash string, scopeID int, receiptID string, opt *PropagationOptions) error {
+	o := opt.withDefault()
+	ctx, cancel := context.WithTimeout(o.Context, o.Timeout)
+	defer cancel()
+
+	var progress float32
+	lastChange := time.Now()
+	interval := o.Interval
+	failures := 0
+	for {
+		s, e := api.CheckConfigUpdateStatus(accountHash, hostHash, scopeID, receiptID)
+		if e != nil {
+			failures++
+			if failures >= o.MaxErrors {
+				return &PropagationError{ReceiptID: receiptID, Progress: progress, API: true, Err: e}
+			}
+		} else {
+			failures = 0
+			if s.Progress != progress {
+				progress = s.Progress
+				lastChange = time.Now()
+			}
+			if o.OnProgress != nil {
+				o.OnProgress(progress)
+			}
+			if o.Progress != nil {
+				select {
+				case o.Progress <- progress:
+				default:
+				}
+			}
+			if progress >= 100 {
+				return nil
+			}
+			if time.Since(lastChange) >= o.StallTimeout {
+				return &PropagationError{ReceiptID: receiptID, Progress: progress, Err: ErrPropagationStalled}
+			}
+		}
+		if api.Log != nil {
+			api.Log.Debug().Str("receiptID", receiptID).Float32("progress", progress).Dur("next", interval).Msg("waiting for configuration propagation")
+		}
+		select {
+		case <-ctx.Done():
+			err := ctx.Err()
+			if err == context.DeadlineExceeded && o.Context.Err() == nil {
+				err = ErrPropagationTimeout
+			}
+			return &PropagationError{ReceiptID: receiptID, Progress: progress, Err: err}
+		case <-time.After(interval):
+		}
+		interval = interval * 3 / 2
+		if interval > o.MaxInterval {
+			interval = o.MaxInterval
+		}
+	}
+}
+
+// UpdateConfigurationAndWait update configuration then wait until it's propagated to all edges
+// updated configuration is returned even if waiting failed
+func (api *HWApi) UpdateConfigurationAndWait(accountHash string, hostHash string, scopeID int, configuration *Configuration, opt *PropagationOptions) (*Configuration, error) {
+	c, e := api.UpdateConfiguration(accountHash, hostHash, scopeID, configuration)
+	if e != nil {
+		return nil, e
+	}
+	return c, api.WaitForConfiguration(accountHash, hostHash, scopeID, c.ID, opt)
+}
@@ -0,0 +1,135 @@
+package hwapi_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/bucloud/hwapi"
+)
+
+// statusServer serve next of progress on every status poll, negative value responds 500
+type statusServer struct {
+	sync.Mutex
+	progress []float32
+	polls    []string
+	puts     int
+}
+
+func (s *statusServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.Lock()
+	defer s.Unlock()
+	if r.Method == http.MethodPut {
+		s.puts++
+		w.Write([]byte(`{"id":"receipt-1","scope":{"id":1}}`))
+		return
+	}
+	s.polls = append(s.polls, r.URL.Path)
+	p := s.progress[0]
+	if len(s.progress) > 1 {
+		s.progress = s.progress[1:]
+	}
+	if p < 0 {
+		http.Error(w, `{"error":"unavailable"}`, http.StatusInternalServerError)
+		return
+	}
+	fmt.Fprintf(w, `{"progress":%g}`, p)
+}
+
+func fastPropagation(o *hwapi.PropagationOptions) *hwapi.PropagationOptions {
+	o.Interval, o.MaxInterval = time.Millisecond, 2*time.Millisecond
+	if o.Timeout == 0 {
+		o.Timeout = 5 * time.Second
+	}
+	if o.StallTimeout == 0 {
+		o.StallTimeout = 5 * time.Second
+	}
+	return o
+}
+
+func TestWaitForConfiguration(t *testing.T) {
+	s := &statusServer{progress: []float32{0, 25, -1, 60, 100}}
+	api := newTestAPI(t, s)
+	seen := []float32{}
+	ch := make(chan float32, 10)
+	if e := api.WaitForConfiguration("acc", "host", 1, "receipt-1", fastPropagation(&hwapi.PropagationOptions{
+		OnProgress: func(p float32) { seen = append(seen, p) },
+		Progress:   ch,
+	})); e != nil {
+		t.Fatal(e)
+	}
+	if want := []float32{0, 25, 60, 100}; !reflect.DeepEqual(seen, want) {
+		t.Errorf("got progress %v, want %v", seen, want)
+	}
+	if len(ch) != 4 {
+		t.Errorf("got %d progress on channel, want 4", len(ch))
+	}
+	if len(s.polls) != 5 || s.polls[0] != "/api/v1/accounts/acc/hosts/host/configuration/1/receipt-1" {
+		t.Errorf("got polls %v", s.polls)
+	}
+}
+
+func TestWaitForConfigurationFailure(t *testing.T) {
+	for _, c := range []struct {
+		name     string
+		progress []float32
+		opt      *hwapi.PropagationOptions
+		err      error
+		api      bool
+		last     float32
+	}{
+		{"stall", []float32{10, 40}, &hwapi.PropagationOptions{StallTimeout: 20 * time.Millisecond}, hwapi.ErrPropagationStalled, false, 40},
+		{"timeout", []float32{10, 20, 30, 40, 50, 60, 70, 80, 90, 99}, &hwapi.PropagationOptions{Timeout: 20 * time.Millisecond}, hwapi.ErrPropagationTimeout, false, -1},
+		{"api", []float32{30, -1}, &hwapi.PropagationOptions{MaxErrors: 2}, nil, true, 30},
+	} {
+		s := &statusServer{progress: c.progress}
+		if c.name == "timeout" {
+			// progress keeps moving so only Timeout could stop waiting
+			for i := 0; i < 1000; i++ {
+				s.progress = append(s.progress, 99+float32(i)/1000)
+			}
+		}
+		e := newTestAPI(t, s).WaitForConfiguration("acc", "host", 1, "receipt-1", fastPropagation(c.opt))
+		pe := &hwapi.PropagationError{}
+		if !errors.As(e, &pe) {
+			t.Errorf("%s: expect PropagationError, got %v", c.name, e)
+			continue
+		}
+		if c.err != nil && !errors.Is(e, c.err) {
+			t.Errorf("%s: expect %v, got %v", c.name, c.err, e)
+		}
+		if pe.API != c.api || pe.ReceiptID != "receipt-1" || (c.last >= 0 && pe.Progress != c.last) {
+			t.Errorf("%s: got %+v", c.name, pe)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	e := newTestAPI(t, &statusServer{progress: []float32{10}}).WaitForConfiguration("acc", "host", 1, "receipt-1", fastPropagation(&hwapi.PropagationOptions{Context: ctx}))
+	if !errors.Is(e, context.Canceled) || errors.Is(e, hwapi.ErrPropagationTimeout) {
+		t.Errorf("expect context.Canceled, got %v", e)
+	}
+}
+
+func TestUpdateConfigurationAndWait(t *testing.T) {
+	s := &statusServer{progress: []float32{50, 100}}
+	c, e := newTestAPI(t, s).UpdateConfigurationAndWait("acc", "host", 1, &hwapi.Configuration{}, fastPropagation(&hwapi.PropagationOptions{}))
+	if e != nil || c == nil || c.ID != "receipt-1" {
+		t.Fatalf("got %v %v", c, e)
+	}
+	if s.puts != 1 || len(s.polls) != 2 || !strings.HasSuffix(s.polls[0], "/configuration/1/receipt-1") {
+		t.Errorf("got %d puts, polls %v", s.puts, s.polls)
+	}
+
+	s = &statusServer{progress: []float32{50}}
+	c, e = newTestAPI(t, s).UpdateConfigurationAndWait("acc", "host", 1, &hwapi.Configuration{}, fastPropagation(&hwapi.PropagationOptions{StallTimeout: 10 * time.Millisecond}))
+	if !errors.Is(e, hwapi.ErrPropagationStalled) || c == nil || c.ID != "receipt-1" {
+		t.Errorf("expect configuration with ErrPropagationStalled, got %v %v", c, e)
+	}
+}
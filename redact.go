@@ -0,0 +1,190 @@
+package hwapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/rs/zerolog"
+)
+
+// RedactedValue replacement of sensitive values in printed configuration
+const RedactedValue = "******"
+
+// sensitiveHeaders request headers removed from debug log
+var sensitiveHeaders = []string{"Authorization", "X-Auth-Token", "Cookie"}
+
+// urlCredentials user:password@ in URLs, such like binding point of authHttpBasic
+var urlCredentials = regexp.MustCompile(`(?i)\b([a-z][a-z0-9+.-]*://[^/:@\s]*):[^/@\s]+@`)
+
+// Sensitive report whether field of policy contains credentials, field is the JSON name
+func (p *PolicyType) Sensitive(field string) bool {
+	for _, f := range p.SensitiveFields() {
+		if f == field {
+			return true
+		}
+	}
+	return false
+}
+
+// SensitiveFields JSON names of fields containing credentials, tagged sensitive:"true" by confgen
+func (p *PolicyType) SensitiveFields() []string {
+	res := []string{}
+	for i := 0; i < p.Type.NumField(); i++ {
+		sf := p.Type.Field(i)
+		if sf.Tag.Get("sensitive") == "true" {
+			res = append(res, strings.Split(sf.Tag.Get("json"), ",")[0])
+		}
+	}
+	return res
+}
+
+// IsSensitiveField report whether field of policy contains credentials, both are JSON names
+func IsSensitiveField(policy string, field string) bool {
+	p := PolicyByName(policy)
+	return p != nil && p.Sensitive(field)
+}
+
+// MarshalRedacted JSON of configuration with sensitive values replaced by RedactedValue
+// Empty values are kept, so missing secrets are still visible
+func (c *Configuration) MarshalRedacted() ([]byte, error) {
+	b, e := json.Marshal(c)
+	if e != nil {
+		return nil, e
+	}
+	m := map[string]interface{}{}
+	if e := json.Unmarshal(b, &m); e != nil {
+		return nil, e
+	}
+	for name, v := range m {
+		m[name] = redactPolicy(PolicyByName(name), v)
+	}
+	return json.Marshal(m)
+}
+
+// redactPolicy redact decoded JSON of policy, v is single policy or list of them
+func redactPolicy(p *PolicyType, v interface{}) interface{} {
+	if p == nil {
+		return redactValue(v)
+	}
+	items, ok := v.([]interface{})
+	if !ok {
+		items = []interface{}{v}
+	}
+	for _, item := range items {
+		obj, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for k, fv := range obj {
+			if p.Sensitive(k) && !isEmptyValue(fv) {
+				obj[k] = RedactedValue
+			} else {
+				obj[k] = redactValue(fv)
+			}
+		}
+	}
+	return v
+}
+
+// redactValue remove credentials embedded in URLs
+func redactValue(v interface{}) interface{} {
+	switch x := v.(type) {
+	case string:
+		return redactURL(x)
+	case map[string]interface{}:
+		for k, e := range x {
+			x[k] = redactValue(e)
+		}
+	case []interface{}:
+		for i, e := range x {
+			x[i] = redactValue(e)
+		}
+	}
+	return v
+}
+
+func redactURL(s string) string {
+	return urlCredentials.ReplaceAllString(s, "${1}:"+RedactedValue+"@")
+}
+
+func isEmptyValue(v interface{}) bool {
+	switch x := v.(type) {
+	case nil:
+		return true
+	case string:
+		return x == ""
+	case []interface{}:
+		return len(x) == 0
+	case map[string]interface{}:
+		return len(x) == 0
+	}
+	return false
+}
+
+// Reveal Convert configuration to JSON including sensitive values
+func (c *Configuration) Reveal() string {
+	s, _ := json.Marshal(c)
+	return string(s)
+}
+
+// MarshalZerologObject log configuration with sensitive values redacted
+// Usage api.Log.Debug().Object("configuration", c).Msg("")
+func (c *Configuration) MarshalZerologObject(e *zerolog.Event) {
+	b, err := c.MarshalRedacted()
+	if err != nil {
+		e.Err(err)
+		return
+	}
+	m := map[string]json.RawMessage{}
+	json.Unmarshal(b, &m)
+	for k, v := range m {
+		e.RawJSON(k, v)
+	}
+}
+
+// redactHeader copy of header with credentials replaced
+func redactHeader(h http.Header) http.Header {
+	r := h.Clone()
+	for _, k := range sensitiveHeaders {
+		if r.Get(k) != "" {
+			r.Set(k, RedactedValue)
+		}
+	}
+	return r
+}
+
+// redactRawURL remove credentials embedded in URL
+func redactRawURL(u *url.URL) string {
+	if _, ok := u.User.Password(); ok {
+		c := *u
+		c.User = url.UserPassword(u.User.Username(), RedactedValue)
+		return c.String()
+	}
+	return u.String()
+}
+
+// redactChangeValue redact value of diff path such like authUrlSign.passPhrase or originPullPolicy[0]
+func redactChangeValue(path string, v interface{}) interface{} {
+	if v == nil {
+		return nil
+	}
+	// work on a copy, change keeps original value
+	b, e := json.Marshal(v)
+	if e != nil {
+		return RedactedValue
+	}
+	var cp interface{}
+	json.Unmarshal(b, &cp)
+	parts := strings.SplitN(path, ".", 3)
+	p := PolicyByName(strings.SplitN(parts[0], "[", 2)[0])
+	if len(parts) == 1 {
+		return redactPolicy(p, cp)
+	}
+	if p != nil && p.Sensitive(strings.SplitN(parts[1], "[", 2)[0]) && !isEmptyValue(cp) {
+		return RedactedValue
+	}
+	return redactValue(cp)
+}
@@ -0,0 +1,328 @@
+package hwapi
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ErrRedirectMapInvalid row of redirect map can't been converted to RedirectMappings
+var ErrRedirectMapInvalid = errors.New("invalid redirect map")
+
+// Redirect map issue kinds
+const (
+	// RedirectIssueDuplicate same filters and trigger code redirect to same URL
+	RedirectIssueDuplicate = "duplicate"
+	// RedirectIssueConflict same filters and trigger code redirect to different URLs
+	RedirectIssueConflict = "conflict"
+	// RedirectIssueLoop redirected URL leads back to itself
+	RedirectIssueLoop = "loop"
+	// RedirectIssueChain redirected URL is redirected again
+	RedirectIssueChain = "chain"
+)
+
+// redirectColumns CSV header => JSON name of RedirectMappings, header is case insensitive
+var redirectColumns = map[string]string{
+	"pathfilter":       "pathFilter",
+	"path":             "pathFilter",
+	"source":           "pathFilter",
+	"from":             "pathFilter",
+	"redirecturl":      "redirectURL",
+	"target":           "redirectURL",
+	"to":               "redirectURL",
+	"url":              "redirectURL",
+	"code":             "code",
+	"replacementtoken": "replacementToken",
+	"token":            "replacementToken",
+	"methodfilter":     "methodFilter",
+	"headerfilter":     "headerFilter",
+	"enabled":          "enabled",
+	"comment":          "comment",
+}
+
+// redirectExportColumns columns written by ExportRedirectCSV
+var redirectExportColumns = []string{"pathFilter", "redirectURL", "code", "replacementToken", "methodFilter", "headerFilter", "enabled", "comment"}
+
+// RedirectMapIssue problem found by CheckRedirectMappings
+type RedirectMapIssue struct {
+	Kind string
+	// Indexes of mappings involved, CSV line is index+2 due to header
+	Indexes []int
+	Message string
+}
+
+func (i *RedirectMapIssue) String() string {
+	return fmt.Sprintf("%s %v: %s", i.Kind, i.Indexes, i.Message)
+}
+
+// ImportRedirectCSV convert CSV rows to RedirectMappings
+// First row is header, recognized columns are pathFilter(path, source, from), redirectURL(target, to, url),
+// code, replacementToken(token), methodFilter, headerFilter, enabled and comment.
+// code is the origin response code which triggers the redirect, such like 404, not the redirect status sent to client.
+// pathFilter, redirectURL and code are required
+func ImportRedirectCSV(r io.Reader) ([]*RedirectMappings, error) {
+	cr := csv.NewReader(r)
+	cr.TrimLeadingSpace = true
+	header, e := cr.Read()
+	if e != nil {
+		return nil, fmt.Errorf("%w: read header, %s", ErrRedirectMapInvalid, e.Error())
+	}
+	columns := make([]string, len(header))
+	for i, h := range header {
+		n, ok := redirectColumns[strings.ToLower(strings.TrimSpace(strings.TrimPrefix(h, "\ufeff")))]
+		if !ok {
+			return nil, fmt.Errorf("%w: unknown column %q", ErrRedirectMapInvalid, h)
+		}
+		columns[i] = n
+	}
+	res := []*RedirectMappings{}
+	for line := 2; ; line++ {
+		row, e := cr.Read()
+		if e == io.EOF {
+			break
+		}
+		if e != nil {
+			return nil, fmt.Errorf("%w: %s", ErrRedirectMapInvalid, e.Error())
+		}
+		m := &RedirectMappings{}
+		for i, v := range row {
+			if e := setRedirectColumn(m, columns[i], strings.TrimSpace(v)); e != nil {
+				return nil, fmt.Errorf("%w: line %d, %s", ErrRedirectMapInvalid, line, e.Error())
+			}
+		}
+		if e := validateRedirectMapping(m); e != nil {
+			return nil, fmt.Errorf("%w: line %d, %s", ErrRedirectMapInvalid, line, e.Error())
+		}
+		res = append(res, m)
+	}
+	return res, nil
+}
+
+func setRedirectColumn(m *RedirectMappings, column string, v string) error {
+	switch column {
+	case "pathFilter":
+		m.PathFilter = v
+	case "redirectURL":
+		m.RedirectURL = v
+	case "code":
+		if v == "" {
+			return nil
+		}
+		c, e := strconv.ParseUint(v, 10, 32)
+		if e != nil {
+			return fmt.Errorf("invalid code %q", v)
+		}
+		m.Code = uint32(c)
+	case "replacementToken":
+		m.ReplacementToken = v
+	case "methodFilter":
+		m.MethodFilter = v
+	case "headerFilter":
+		m.HeaderFilter = v
+	case "enabled":
+		if v == "" {
+			return nil
+		}
+		b, e := strconv.ParseBool(v)
+		if e != nil {
+			return fmt.Errorf("invalid enabled %q", v)
+		}
+		m.Enabled = &b
+	case "comment":
+		m.Comment = v
+	}
+	return nil
+}
+
+func validateRedirectMapping(m *RedirectMappings) error {
+	switch {
+	case m.PathFilter == "":
+		return errors.New("pathFilter is required")
+	case m.RedirectURL == "":
+		return errors.New("redirectURL is required")
+	case m.Code == 0:
+		return errors.New("code is required")
+	case m.Code < 100 || m.Code > 599:
+		return fmt.Errorf("code %d isn't an HTTP response code", m.Code)
+	}
+	for _, f := range [][2]string{{"pathFilter", m.PathFilter}, {"methodFilter", m.MethodFilter}, {"headerFilter", m.HeaderFilter}} {
+		if _, e := ParseFilter(f[1]); e != nil {
+			return fmt.Errorf("%s: %s", f[0], e.Error())
+		}
+	}
+	return nil
+}
+
+// ImportRedirectJSON convert JSON array of RedirectMappings, keys are same as configuration
+func ImportRedirectJSON(r io.Reader) ([]*RedirectMappings, error) {
+	res := []*RedirectMappings{}
+	if e := json.NewDecoder(r).Decode(&res); e != nil {
+		return nil, fmt.Errorf("%w: %s", ErrRedirectMapInvalid, e.Error())
+	}
+	for i, m := range res {
+		if m == nil {
+			return nil, fmt.Errorf("%w: item %d is null", ErrRedirectMapInvalid, i)
+		}
+		if len(m.Extra) > 0 {
+			return nil, fmt.Errorf("%w: item %d contains unknown fields %v", ErrRedirectMapInvalid, i, sortedRawKeys(m.Extra))
+		}
+		if e := validateRedirectMapping(m); e != nil {
+			return nil, fmt.Errorf("%w: item %d, %s", ErrRedirectMapInvalid, i, e.Error())
+		}
+	}
+	return res, nil
+}
+
+func sortedRawKeys(m map[string]json.RawMessage) []string {
+	res := []string{}
+	for k := range m {
+		res = append(res, k)
+	}
+	sort.Strings(res)
+	return res
+}
+
+// ExportRedirectCSV write mappings as CSV which could been read by ImportRedirectCSV
+func ExportRedirectCSV(w io.Writer, list []*RedirectMappings) error {
+	cw := csv.NewWriter(w)
+	if e := cw.Write(redirectExportColumns); e != nil {
+		return e
+	}
+	for _, m := range list {
+		if m == nil {
+			continue
+		}
+		enabled := ""
+		if m.Enabled != nil {
+			enabled = strconv.FormatBool(*m.Enabled)
+		}
+		if e := cw.Write([]string{m.PathFilter, m.RedirectURL, strconv.FormatUint(uint64(m.Code), 10), m.ReplacementToken, m.MethodFilter, m.HeaderFilter, enabled, m.Comment}); e != nil {
+			return e
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// redirectTarget URL redirected to, replacement token is removed
+// nil if target is on other host
+func redirectTarget(m *RedirectMappings, hosts []string) *url.URL {
+	target := m.RedirectURL
+	if m.ReplacementToken != "" {
+		target = strings.ReplaceAll(target, m.ReplacementToken, "")
+	}
+	u, e := url.Parse(target)
+	if e != nil {
+		return nil
+	}
+	if u.Host == "" {
+		if !strings.HasPrefix(u.Path, "/") {
+			u.Path = "/" + u.Path
+		}
+		return u
+	}
+	if len(hosts) == 0 {
+		return u
+	}
+	for _, h := range hosts {
+		if strings.EqualFold(u.Hostname(), h) {
+			return u
+		}
+	}
+	return nil
+}
+
+// CheckRedirectMappings find duplicates, loops and chains in mappings
+// hosts are hostnames of the site, absolute redirect URLs to other hosts are ignored,
+// if hosts is empty every absolute URL is treated as same site.
+// A mapping only fires when origin responds with its code, the redirect target is assumed to fail the same way
+// as the redirected URL, so target is chained only to mappings with the same trigger code
+func CheckRedirectMappings(list []*RedirectMappings, hosts ...string) []*RedirectMapIssue {
+	res := []*RedirectMapIssue{}
+
+	// first mapping with same filters and trigger code wins, others are never applied
+	first := make([]bool, len(list))
+	seen := map[string]int{}
+	for i, m := range list {
+		key := fmt.Sprintf("%s\n%s\n%s\n%d", normalizeFilter(m.PathFilter), normalizeFilter(m.MethodFilter), normalizeFilter(m.HeaderFilter), m.Code)
+		j, ok := seen[key]
+		if !ok {
+			seen[key] = i
+			first[i] = true
+			continue
+		}
+		if list[j].RedirectURL == m.RedirectURL {
+			res = append(res, &RedirectMapIssue{Kind: RedirectIssueDuplicate, Indexes: []int{j, i}, Message: fmt.Sprintf("%s already redirected to %s", m.PathFilter, m.RedirectURL)})
+		} else {
+			res = append(res, &RedirectMapIssue{Kind: RedirectIssueConflict, Indexes: []int{j, i}, Message: fmt.Sprintf("%s redirected to both %s and %s", m.PathFilter, list[j].RedirectURL, m.RedirectURL)})
+		}
+	}
+
+	// next[i] mapping applied to target of i, -1 if none, target is expected to return code of i
+	next := make([]int, len(list))
+	from := make([]bool, len(list))
+	for i, m := range list {
+		next[i] = -1
+		u := redirectTarget(m, hosts)
+		if u == nil || !first[i] {
+			continue
+		}
+		for j, n := range list {
+			if !first[j] || n.Code != m.Code {
+				continue
+			}
+			f, e := ParseFilter(n.PathFilter)
+			if e == nil && f.MatchURL(u) {
+				next[i] = j
+				from[j] = true
+				break
+			}
+		}
+	}
+
+	// walk from mappings nobody redirects to, then the rest which must be loops
+	walked := make([]bool, len(list))
+	inLoop := make([]bool, len(list))
+	walk := func(i int) {
+		path := []int{i}
+		pos := map[int]int{i: 0}
+		walked[i] = true
+		for j := next[i]; j >= 0; j = next[j] {
+			if k, ok := pos[j]; ok {
+				cycle := path[k:]
+				if !inLoop[j] {
+					for _, c := range cycle {
+						inLoop[c] = true
+					}
+					res = append(res, &RedirectMapIssue{Kind: RedirectIssueLoop, Indexes: cycle, Message: fmt.Sprintf("%s redirects back to itself on %d", list[j].PathFilter, list[j].Code)})
+				}
+				if k == 0 {
+					return
+				}
+				path = path[:k+1]
+				break
+			}
+			pos[j] = len(path)
+			path = append(path, j)
+			walked[j] = true
+		}
+		res = append(res, &RedirectMapIssue{Kind: RedirectIssueChain, Indexes: path, Message: fmt.Sprintf("%s is redirected %d times on %d", list[i].PathFilter, len(path), list[i].Code)})
+	}
+	for i := range list {
+		if next[i] >= 0 && !from[i] {
+			walk(i)
+		}
+	}
+	for i := range list {
+		if next[i] >= 0 && !walked[i] {
+			walk(i)
+		}
+	}
+	return res
+}
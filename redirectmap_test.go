@@ -0,0 +1,69 @@
+package hwapi_test
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/bucloud/hwapi"
+)
+
+func TestRedirectMapCSV(t *testing.T) {
+	src := "Source,Target,Code,Token\n" +
+		"/old,/new,404,\n" +
+		"/new,/newest,404,\n" +
+		"/a,/b,404,\n" +
+		"/b,/a,404,\n" +
+		"/old,/new,404,\n" +
+		"/old,/other,404,\n" +
+		"/self,http://www.example.com/self?from=%URL%,404,%URL%\n" +
+		"/away,http://other.com/old,404,\n" +
+		"/old,/maintenance,503,\n" +
+		"/maintenance,/new,410,\n"
+	list, e := hwapi.ImportRedirectCSV(strings.NewReader(src))
+	if e != nil {
+		t.Fatal(e)
+	}
+	if len(list) != 10 || list[6].ReplacementToken != "%URL%" || list[0].Code != 404 {
+		t.Fatalf("imported %d mappings", len(list))
+	}
+
+	got := map[string]bool{}
+	for _, i := range hwapi.CheckRedirectMappings(list, "www.example.com") {
+		got[i.String()[:strings.Index(i.String(), ":")]] = true
+	}
+	for _, want := range []string{"duplicate [0 4]", "conflict [0 5]", "chain [0 1]", "loop [2 3]", "loop [6]"} {
+		if !got[want] {
+			t.Errorf("missing issue %s, got %v", want, got)
+		}
+	}
+	if len(got) != 5 {
+		t.Errorf("unexpected issues %v", got)
+	}
+
+	buf := &bytes.Buffer{}
+	if e := hwapi.ExportRedirectCSV(buf, list); e != nil {
+		t.Fatal(e)
+	}
+	again, e := hwapi.ImportRedirectCSV(buf)
+	if e != nil || len(again) != len(list) || again[6].RedirectURL != list[6].RedirectURL {
+		t.Errorf("export round trip failed, %v", e)
+	}
+
+	for _, bad := range []string{"path,target,code\n/a,,404\n", "path,foo\n/a,b\n", "path,target,code\n/a,/b,abc\n", "path,target,code\n/a,/b,1000\n", "path,target,status\n/a,/b,404\n"} {
+		if _, e := hwapi.ImportRedirectCSV(strings.NewReader(bad)); !errors.Is(e, hwapi.ErrRedirectMapInvalid) {
+			t.Errorf("%q: error %v", bad, e)
+		}
+	}
+}
+
+func TestRedirectMapJSON(t *testing.T) {
+	list, e := hwapi.ImportRedirectJSON(strings.NewReader(`[{"pathFilter":"/x","redirectURL":"/y","code":404}]`))
+	if e != nil || len(list) != 1 || list[0].RedirectURL != "/y" {
+		t.Fatalf("import failed, %v", e)
+	}
+	if _, e := hwapi.ImportRedirectJSON(strings.NewReader(`[{"pathFilter":"/x","redirectURL":"/y","code":404,"target":"/z"}]`)); !errors.Is(e, hwapi.ErrRedirectMapInvalid) {
+		t.Errorf("unknown field accepted, %v", e)
+	}
+}
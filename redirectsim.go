@@ -0,0 +1,219 @@
+package hwapi
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// defaultRedirectCode used by RedirectMappings if no RedirectExceptions matches user agent
+const defaultRedirectCode = http.StatusMovedPermanently
+
+// RedirectRequest request and origin response evaluated by SimulateRedirect
+type RedirectRequest struct {
+	*PolicyRequest
+	// Status response code of origin, default 200
+	Status int
+	// Location header of origin response
+	Location string
+}
+
+// RedirectResult response returned to client
+type RedirectResult struct {
+	Status   int
+	Location string
+	// Policy name of policy which produced the response, empty if origin response is passed through
+	Policy string
+	// Index of groupable policy
+	Index int
+	// Trace explain how policies were evaluated
+	Trace []string
+}
+
+func (r *RedirectResult) tracef(format string, args ...interface{}) {
+	r.Trace = append(r.Trace, fmt.Sprintf(format, args...))
+}
+
+// SimulateRedirect evaluate languageRedirect, redirectMappings, redirectExceptions and preserveRedirectHost
+// of configuration, usually an EffectiveConfiguration, against request and origin response.
+// Policies are applied in this order:
+//
+// languageRedirect rewrites origin response with httpCode to 301 when path matches pathRegex,
+// the requested language is the first capture group of pathRegex, which is replaced by mapped language,
+// such like "fr-ca|fr-fr=fr,*=en". pathRegex without capture group never redirects.
+//
+// redirectMappings redirects origin response with code to redirectURL, replacementToken in redirectURL is
+// replaced by the request URL, redirect code is chosen by redirectExceptions on User-Agent, default 301.
+//
+// preserveRedirectHost replaces host of origin Location with request host, if origin status is listed.
+func (c *Configuration) SimulateRedirect(req *RedirectRequest) (*RedirectResult, error) {
+	status := req.Status
+	if status == 0 {
+		status = http.StatusOK
+	}
+	res := &RedirectResult{Status: status, Location: req.Location, Trace: []string{}}
+
+	for i, p := range c.LanguageRedirect {
+		if p == nil || !policyEnabledValue(reflect.ValueOf(p).Elem(), true) {
+			continue
+		}
+		if int(p.HTTPCode) != status {
+			res.tracef("languageRedirect[%d]: httpCode %d doesn't match %d", i, p.HTTPCode, status)
+			continue
+		}
+		loc, e := languageRedirectLocation(p, req.PolicyRequest)
+		if e != nil {
+			return nil, fmt.Errorf("languageRedirect[%d]: %w", i, e)
+		}
+		if loc == "" {
+			res.tracef("languageRedirect[%d]: path or language doesn't match", i)
+			continue
+		}
+		res.Status, res.Location, res.Policy, res.Index = http.StatusMovedPermanently, loc, "languageRedirect", i
+		res.tracef("languageRedirect[%d]: redirect to %s", i, loc)
+		return res, nil
+	}
+
+	for i, p := range c.RedirectMappings {
+		if p == nil {
+			continue
+		}
+		if int(p.Code) != status {
+			res.tracef("redirectMappings[%d]: code %d doesn't match %d", i, p.Code, status)
+			continue
+		}
+		if m, filters := ExplainPolicy(p, req.PolicyRequest); !m {
+			res.tracef("redirectMappings[%d]: disabled or filters don't match %s", i, filterResultsString(filters))
+			continue
+		}
+		loc := p.RedirectURL
+		if p.ReplacementToken != "" {
+			loc = strings.ReplaceAll(loc, p.ReplacementToken, req.URL.String())
+		}
+		code, e := c.redirectExceptionCode(req.Header.Get("User-Agent"))
+		if e != nil {
+			return nil, e
+		}
+		res.Status, res.Location, res.Policy, res.Index = code, loc, "redirectMappings", i
+		res.tracef("redirectMappings[%d]: %d redirect to %s", i, code, loc)
+		return res, nil
+	}
+
+	if p := c.PreserveRedirectHost; p != nil && req.Location != "" && policyEnabledValue(reflect.ValueOf(p).Elem(), true) {
+		codes, e := parseStatusCodes(p.StatusCodes)
+		if e != nil {
+			return nil, fmt.Errorf("preserveRedirectHost: %w", e)
+		}
+		if codes[status] {
+			loc, e := url.Parse(req.Location)
+			if e != nil {
+				return nil, fmt.Errorf("origin location: %w", e)
+			}
+			if loc.Host != "" && loc.Host != req.URL.Host {
+				loc.Host = req.URL.Host
+				res.Location, res.Policy = loc.String(), "preserveRedirectHost"
+				res.tracef("preserveRedirectHost: location host replaced by %s", req.URL.Host)
+			}
+		} else {
+			res.tracef("preserveRedirectHost: status %d not in %s", status, p.StatusCodes)
+		}
+	}
+	return res, nil
+}
+
+func filterResultsString(filters []*FilterResult) string {
+	l := []string{}
+	for _, f := range filters {
+		if !f.Matched {
+			l = append(l, fmt.Sprintf("%s=%q", f.Field, f.Expr))
+		}
+	}
+	return strings.Join(l, ",")
+}
+
+// redirectExceptionCode redirect code of user agent, pairs are evaluated in order
+func (c *Configuration) redirectExceptionCode(userAgent string) (int, error) {
+	p := c.RedirectExceptions
+	if p == nil || !policyEnabledValue(reflect.ValueOf(p).Elem(), true) {
+		return defaultRedirectCode, nil
+	}
+	for _, pair := range strings.Split(p.RedirectAgentCode, ",") {
+		pair = strings.TrimSpace(pair)
+		i := strings.LastIndex(pair, ":")
+		if i < 0 {
+			continue
+		}
+		code, e := strconv.Atoi(strings.TrimSpace(pair[i+1:]))
+		if e != nil {
+			return 0, fmt.Errorf("redirectExceptions: invalid code in %q", pair)
+		}
+		re, e := regexp.Compile("(?i)" + wildcardToRegexp(strings.TrimSpace(pair[:i]), true))
+		if e != nil {
+			return 0, fmt.Errorf("redirectExceptions: %w", e)
+		}
+		if re.MatchString(userAgent) {
+			return code, nil
+		}
+	}
+	return defaultRedirectCode, nil
+}
+
+// parseStatusCodes comma separated status codes
+func parseStatusCodes(s string) (map[int]bool, error) {
+	res := map[int]bool{}
+	for _, c := range strings.Split(s, ",") {
+		if c = strings.TrimSpace(c); c == "" {
+			continue
+		}
+		n, e := strconv.Atoi(c)
+		if e != nil {
+			return nil, fmt.Errorf("invalid status code %q", c)
+		}
+		res[n] = true
+	}
+	return res, nil
+}
+
+// languageRedirectLocation URL redirected to, empty if path or language doesn't match
+func languageRedirectLocation(p *LanguageRedirect, req *PolicyRequest) (string, error) {
+	re, e := regexp.Compile(p.PathRegex)
+	if e != nil {
+		return "", e
+	}
+	path := req.URL.Path
+	// language code is the first capture group of pathRegex
+	m := re.FindStringSubmatchIndex(path)
+	if len(m) < 4 || m[2] < 0 {
+		return "", nil
+	}
+	start, end := m[2], m[3]
+	requested := path[start:end]
+	lang := mapLanguage(p.Mapping, requested)
+	if lang == "" || strings.EqualFold(lang, requested) {
+		return "", nil
+	}
+	u := *req.URL
+	u.Path = path[:start] + lang + path[end:]
+	u.RawPath = ""
+	return u.String(), nil
+}
+
+// mapLanguage apply first matched mapping, such like "fr-ca|fr-fr=fr,*=en"
+func mapLanguage(mapping string, requested string) string {
+	for _, m := range strings.Split(mapping, ",") {
+		kv := strings.SplitN(strings.TrimSpace(m), "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		for _, from := range strings.FieldsFunc(kv[0], func(r rune) bool { return r == '|' || r == ' ' }) {
+			if from == "*" || strings.EqualFold(from, requested) {
+				return strings.TrimSpace(kv[1])
+			}
+		}
+	}
+	return ""
+}
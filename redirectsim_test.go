@@ -0,0 +1,66 @@
+package hwapi_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/bucloud/hwapi"
+)
+
+func TestSimulateRedirect(t *testing.T) {
+	c := &hwapi.Configuration{}
+	if e := json.Unmarshal([]byte(`{
+		"languageRedirect":[{"pathRegex":"^/([a-z]{2}-[a-z]{2})/","mapping":"fr-ca|fr-be=fr,*=en","httpCode":404}],
+		"redirectMappings":[
+			{"code":404,"redirectURL":"http://www.example.com/404?from=%URL%","replacementToken":"%URL%","pathFilter":"/shop/*"},
+			{"code":500,"redirectURL":"http://www.example.com/error","methodFilter":"POST"}
+		],
+		"redirectExceptions":{"redirectAgentCode":"*Googlebot*:301,Mozilla*:302"},
+		"preserveRedirectHost":{"statusCodes":"301,302"}
+	}`), c); e != nil {
+		t.Fatal(e)
+	}
+	cases := []struct {
+		url      string
+		method   string
+		ua       string
+		status   int
+		location string
+		want     int
+		wantLoc  string
+		policy   string
+	}{
+		{"http://www.example.com/fr-ca/a.html", "GET", "", 404, "", 301, "http://www.example.com/fr/a.html", "languageRedirect"},
+		{"http://www.example.com/de-de/a.html", "GET", "", 404, "", 301, "http://www.example.com/en/a.html", "languageRedirect"},
+		{"http://www.example.com/shop/x", "GET", "Mozilla/5.0", 404, "", 302, "http://www.example.com/404?from=http://www.example.com/shop/x", "redirectMappings"},
+		{"http://www.example.com/shop/x", "GET", "Googlebot Mozilla", 404, "", 301, "http://www.example.com/404?from=http://www.example.com/shop/x", "redirectMappings"},
+		{"http://www.example.com/shop/x", "GET", "curl", 500, "", 500, "", ""},
+		{"http://www.example.com/shop/x", "POST", "curl", 500, "", 301, "http://www.example.com/error", "redirectMappings"},
+		{"http://www.example.com/a", "GET", "", 302, "http://origin.internal/b", 302, "http://www.example.com/b", "preserveRedirectHost"},
+		{"http://www.example.com/a", "GET", "", 307, "http://origin.internal/b", 307, "http://origin.internal/b", ""},
+		{"http://www.example.com/a", "GET", "", 0, "", 200, "", ""},
+	}
+	for _, tc := range cases {
+		pr, e := hwapi.NewPolicyRequest(tc.method, tc.url, http.Header{"User-Agent": {tc.ua}}, "")
+		if e != nil {
+			t.Fatal(e)
+		}
+		r, e := c.SimulateRedirect(&hwapi.RedirectRequest{PolicyRequest: pr, Status: tc.status, Location: tc.location})
+		if e != nil {
+			t.Fatal(e)
+		}
+		if r.Status != tc.want || r.Location != tc.wantLoc || r.Policy != tc.policy {
+			t.Errorf("%s %s %d: got %d %q %q, want %d %q %q\n%v", tc.method, tc.url, tc.status, r.Status, r.Location, r.Policy, tc.want, tc.wantLoc, tc.policy, r.Trace)
+		}
+	}
+}
+
+func TestSimulateLanguageRedirectWithoutGroup(t *testing.T) {
+	c := &hwapi.Configuration{LanguageRedirect: []*hwapi.LanguageRedirect{{PathRegex: "^/docs/", Mapping: "fr-ca=fr,*=en", HTTPCode: 404}}}
+	pr, _ := hwapi.NewPolicyRequest("GET", "http://www.example.com/docs/a", http.Header{"Accept-Language": {"fr-CA"}}, "")
+	r, e := c.SimulateRedirect(&hwapi.RedirectRequest{PolicyRequest: pr, Status: 404})
+	if e != nil || r.Status != 404 || r.Location != "" || r.Policy != "" {
+		t.Errorf("got %v %v", r, e)
+	}
+}
@@ -0,0 +1,247 @@
+package hwapi
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+)
+
+// S3PostAlgorithm x-amz-algorithm of S3 POST v4 forms
+const S3PostAlgorithm = "AWS4-HMAC-SHA256"
+
+const (
+	s3PostDateLayout  = "20060102T150405Z"
+	s3PostScopeLayout = "20060102"
+	// s3PostExpiration expiration of POST policy, ISO8601 in UTC
+	s3PostExpiration = "2006-01-02T15:04:05.000Z"
+)
+
+// S3PostSigner build browser upload forms accepted by awsSignedS3PostV4
+//
+// Policy document is base64 encoded JSON of expiration and conditions, signature is
+// HMAC-SHA256 of encoded policy with SigV4 signing key derived from secretAccessKey, date, awsRegion and awsService.
+type S3PostSigner struct {
+	Policy *AwsSignedS3PostV4
+	// Scheme of form action URL, default https
+	Scheme string
+
+	bucket string
+	host   string
+	path   string
+}
+
+// S3PostOptions conditions of upload form
+type S3PostOptions struct {
+	// Key object key, may contain ${filename}, default KeyPrefix + "${filename}"
+	Key string
+	// KeyPrefix uploaded key must start with, key is exact match if empty
+	KeyPrefix string
+	// ContentType exact Content-Type of upload, ContentTypePrefix allows any type starting with it such like "image/"
+	ContentType       string
+	ContentTypePrefix string
+	// MinLength and MaxLength bytes of file, content-length-range is added if MaxLength > 0
+	MinLength int64
+	MaxLength int64
+	// Fields extra form fields such like acl or success_action_status, each is matched exactly
+	Fields map[string]string
+	// Now sign time, default time.Now()
+	Now time.Time
+	// Expires expiration of policy, default Now + expireTimeSeconds
+	Expires time.Time
+}
+
+// S3PostPolicy POST policy document
+type S3PostPolicy struct {
+	Expiration string        `json:"expiration"`
+	Conditions []interface{} `json:"conditions"`
+}
+
+// S3PostForm action URL and fields of upload form, file field must be sent last
+type S3PostForm struct {
+	URL    string
+	Fields map[string]string
+	Policy *S3PostPolicy
+}
+
+// parseBucketIdentifier split bucketIdentifier into bucket name, host and path of form action
+// bucket.s3.amazonaws.com, s3.amazonaws.com/bucket and custom hostnames are supported
+func parseBucketIdentifier(id string) (string, string, string) {
+	id = strings.Trim(id, "/")
+	if i := strings.Index(id, "/"); i >= 0 {
+		bucket := strings.SplitN(id[i+1:], "/", 2)[0]
+		return bucket, id[:i], "/" + bucket
+	}
+	if i := strings.Index(id, ".s3"); i > 0 && strings.HasSuffix(id, ".amazonaws.com") {
+		return id[:i], id, "/"
+	}
+	return id, id, "/"
+}
+
+// NewS3PostSigner create upload form signer of awsSignedS3PostV4 policy
+// accessKeyId, secretAccessKey, awsRegion and bucketIdentifier are required
+func NewS3PostSigner(p *AwsSignedS3PostV4) (*S3PostSigner, error) {
+	switch {
+	case p == nil:
+		return nil, fmt.Errorf("%w: awsSignedS3PostV4 is nil", ErrSignerPolicy)
+	case p.AccessKeyID == "" || p.SecretAccessKey == "":
+		return nil, fmt.Errorf("%w: awsSignedS3PostV4 accessKeyId and secretAccessKey are required", ErrSignerPolicy)
+	case p.AwsRegion == "":
+		return nil, fmt.Errorf("%w: awsSignedS3PostV4 awsRegion is required", ErrSignerPolicy)
+	}
+	s := &S3PostSigner{Policy: p}
+	if s.bucket, s.host, s.path = parseBucketIdentifier(p.BucketIdentifier); s.bucket == "" {
+		return nil, fmt.Errorf("%w: awsSignedS3PostV4 bucketIdentifier is required", ErrSignerPolicy)
+	}
+	return s, nil
+}
+
+// Bucket name parsed from bucketIdentifier
+func (s *S3PostSigner) Bucket() string {
+	return s.bucket
+}
+
+// Credential x-amz-credential of sign time
+func (s *S3PostSigner) Credential(t time.Time) string {
+	return strings.Join([]string{s.Policy.AccessKeyID, t.UTC().Format(s3PostScopeLayout), s.Policy.AwsRegion, s.service(), "aws4_request"}, "/")
+}
+
+func (s *S3PostSigner) service() string {
+	return stringDefault(s.Policy.AwsService, "s3")
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	m := hmac.New(sha256.New, key)
+	m.Write([]byte(data))
+	return m.Sum(nil)
+}
+
+// SigningKey SigV4 signing key of date
+func (s *S3PostSigner) SigningKey(t time.Time) []byte {
+	k := hmacSHA256([]byte("AWS4"+s.Policy.SecretAccessKey), t.UTC().Format(s3PostScopeLayout))
+	k = hmacSHA256(k, s.Policy.AwsRegion)
+	k = hmacSHA256(k, s.service())
+	return hmacSHA256(k, "aws4_request")
+}
+
+// Form build upload form, fields and conditions
+func (s *S3PostSigner) Form(opt *S3PostOptions) (*S3PostForm, error) {
+	if opt == nil {
+		opt = &S3PostOptions{}
+	}
+	if opt.MaxLength < 0 || opt.MinLength < 0 || (opt.MaxLength > 0 && opt.MinLength > opt.MaxLength) {
+		return nil, fmt.Errorf("%w: invalid content length range %d-%d", ErrSignerPolicy, opt.MinLength, opt.MaxLength)
+	}
+	if opt.ContentType != "" && opt.ContentTypePrefix != "" && !strings.HasPrefix(opt.ContentType, opt.ContentTypePrefix) {
+		return nil, fmt.Errorf("%w: content type %q doesn't start with %q", ErrSignerPolicy, opt.ContentType, opt.ContentTypePrefix)
+	}
+	now := opt.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+	now = now.UTC()
+	exp := opt.Expires
+	if exp.IsZero() {
+		ttl := s.Policy.ExpireTimeSeconds
+		if ttl == 0 {
+			ttl = 5
+		}
+		exp = now.Add(time.Duration(ttl) * time.Second)
+	}
+	key := stringDefault(opt.Key, opt.KeyPrefix+"${filename}")
+	if opt.KeyPrefix != "" && !strings.HasPrefix(key, opt.KeyPrefix) {
+		return nil, fmt.Errorf("%w: key %q doesn't start with %q", ErrSignerPolicy, key, opt.KeyPrefix)
+	}
+
+	fields := map[string]string{
+		"key":              key,
+		"x-amz-algorithm":  S3PostAlgorithm,
+		"x-amz-credential": s.Credential(now),
+		"x-amz-date":       now.Format(s3PostDateLayout),
+	}
+	policy := &S3PostPolicy{
+		Expiration: exp.UTC().Format(s3PostExpiration),
+		Conditions: []interface{}{map[string]string{"bucket": s.bucket}},
+	}
+	if opt.KeyPrefix != "" {
+		policy.Conditions = append(policy.Conditions, []string{"starts-with", "$key", opt.KeyPrefix})
+	} else {
+		policy.Conditions = append(policy.Conditions, map[string]string{"key": key})
+	}
+	if opt.ContentType != "" {
+		fields["Content-Type"] = opt.ContentType
+	}
+	if opt.ContentTypePrefix != "" {
+		policy.Conditions = append(policy.Conditions, []string{"starts-with", "$Content-Type", opt.ContentTypePrefix})
+	} else if opt.ContentType != "" {
+		policy.Conditions = append(policy.Conditions, map[string]string{"Content-Type": opt.ContentType})
+	}
+	if opt.MaxLength > 0 {
+		policy.Conditions = append(policy.Conditions, []interface{}{"content-length-range", opt.MinLength, opt.MaxLength})
+	}
+	names := []string{}
+	for k := range opt.Fields {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	for _, k := range names {
+		if _, ok := fields[k]; ok || strings.EqualFold(k, "policy") || strings.EqualFold(k, "x-amz-signature") || strings.EqualFold(k, "file") {
+			return nil, fmt.Errorf("%w: field %q is reserved", ErrSignerPolicy, k)
+		}
+		fields[k] = opt.Fields[k]
+		policy.Conditions = append(policy.Conditions, map[string]string{k: opt.Fields[k]})
+	}
+	for _, k := range []string{"x-amz-algorithm", "x-amz-credential", "x-amz-date"} {
+		policy.Conditions = append(policy.Conditions, map[string]string{k: fields[k]})
+	}
+
+	doc, e := json.Marshal(policy)
+	if e != nil {
+		return nil, e
+	}
+	fields["policy"] = base64.StdEncoding.EncodeToString(doc)
+	fields["x-amz-signature"] = hex.EncodeToString(hmacSHA256(s.SigningKey(now), fields["policy"]))
+	scheme := stringDefault(s.Scheme, "https")
+	return &S3PostForm{URL: scheme + "://" + s.host + s.path, Fields: fields, Policy: policy}, nil
+}
+
+// Request build multipart POST request uploading r as filename, fields are written in name order before file
+func (f *S3PostForm) Request(filename string, r io.Reader) (*http.Request, error) {
+	body := &bytes.Buffer{}
+	w := multipart.NewWriter(body)
+	names := []string{}
+	for k := range f.Fields {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	for _, k := range names {
+		if e := w.WriteField(k, f.Fields[k]); e != nil {
+			return nil, e
+		}
+	}
+	fw, e := w.CreateFormFile("file", filename)
+	if e != nil {
+		return nil, e
+	}
+	if _, e := io.Copy(fw, r); e != nil {
+		return nil, e
+	}
+	if e := w.Close(); e != nil {
+		return nil, e
+	}
+	req, e := http.NewRequest(http.MethodPost, f.URL, body)
+	if e != nil {
+		return nil, e
+	}
+	req.Header.Set("Content-Type", w.FormDataContentType())
+	return req, nil
+}
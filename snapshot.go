@@ -0,0 +1,259 @@
+package hwapi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrSnapshotNotFound snapshot ID not exists in store
+var ErrSnapshotNotFound = errors.New("snapshot not found")
+
+// ConfigurationSnapshot configuration of a scope saved before it's updated
+type ConfigurationSnapshot struct {
+	ID          string    `json:"id"`
+	AccountHash string    `json:"accountHash"`
+	HostHash    string    `json:"hostHash"`
+	ScopeID     int       `json:"scopeId"`
+	CreatedAt   time.Time `json:"createdAt"`
+	// User username of CurrentUser who made the update
+	User string `json:"user,omitempty"`
+	// ReceiptID of the update which replaced this configuration
+	ReceiptID     string         `json:"receiptId,omitempty"`
+	Configuration *Configuration `json:"configuration"`
+}
+
+// SnapshotStore pluggable storage of snapshots
+type SnapshotStore interface {
+	Save(s *ConfigurationSnapshot) error
+	// List snapshots of scope, newest first
+	List(accountHash string, hostHash string, scopeID int) ([]*ConfigurationSnapshot, error)
+	Get(id string) (*ConfigurationSnapshot, error)
+}
+
+// SetSnapshotStore enable snapshot before every UpdateConfiguration, nil disables it
+func (api *HWApi) SetSnapshotStore(s SnapshotStore) {
+	api.snapshots = s
+}
+
+// newSnapshotID sortable unique id
+func newSnapshotID(hostHash string, scopeID int, t time.Time) string {
+	return fmt.Sprintf("%s-%d-%s", hostHash, scopeID, t.UTC().Format("20060102T150405.000000000Z"))
+}
+
+// newSnapshot create snapshot of configuration which is going to be replaced
+func (api *HWApi) newSnapshot(accountHash string, hostHash string, scopeID int, c *Configuration) *ConfigurationSnapshot {
+	now := time.Now()
+	s := &ConfigurationSnapshot{
+		ID:            newSnapshotID(hostHash, scopeID, now),
+		AccountHash:   accountHash,
+		HostHash:      hostHash,
+		ScopeID:       scopeID,
+		CreatedAt:     now,
+		Configuration: c,
+	}
+	if api.CurrentUser != nil {
+		s.User = api.CurrentUser.UserName
+	}
+	return s
+}
+
+// saveSnapshot save snapshot with receipt ID of the update
+func (api *HWApi) saveSnapshot(s *ConfigurationSnapshot, receiptID string) error {
+	s.ReceiptID = receiptID
+	if e := api.snapshots.Save(s); e != nil {
+		return fmt.Errorf("configuration updated but save snapshot failed, %w", e)
+	}
+	return nil
+}
+
+// ListSnapshots list snapshots of scope, newest first
+func (api *HWApi) ListSnapshots(accountHash string, hostHash string, scopeID int) ([]*ConfigurationSnapshot, error) {
+	if api.snapshots == nil {
+		return []*ConfigurationSnapshot{}, nil
+	}
+	return api.snapshots.List(accountHash, hostHash, scopeID)
+}
+
+// PreviewRestore diff current configuration of scope against snapshot
+func (api *HWApi) PreviewRestore(snapshotID string) ([]*ConfigurationChange, error) {
+	if api.snapshots == nil {
+		return nil, ErrSnapshotNotFound
+	}
+	s, e := api.snapshots.Get(snapshotID)
+	if e != nil {
+		return nil, e
+	}
+	c, e := api.GetConfiguration(s.AccountHash, s.HostHash, s.ScopeID)
+	if e != nil {
+		return nil, e
+	}
+	return DiffConfiguration(c, s.Configuration)
+}
+
+// RestoreSnapshot write snapshot back to its scope, current configuration is snapshotted as well
+// Policy types added after snapshot are sent as null, or [] if groupable, since types missing in body are kept by API
+func (api *HWApi) RestoreSnapshot(snapshotID string) (*Configuration, error) {
+	if api.snapshots == nil {
+		return nil, ErrSnapshotNotFound
+	}
+	s, e := api.snapshots.Get(snapshotID)
+	if e != nil {
+		return nil, e
+	}
+	current, e := api.GetConfiguration(s.AccountHash, s.HostHash, s.ScopeID)
+	if e != nil {
+		return nil, fmt.Errorf("snapshot configuration failed, %w", e)
+	}
+	body, e := api.restoreBody(current, s.Configuration)
+	if e != nil {
+		return nil, e
+	}
+	return api.putConfiguration(s.AccountHash, s.HostHash, s.ScopeID, body, current)
+}
+
+// restoreBody encode snapshot, policy types present in current but absent from snapshot are removed explicitly
+func (api *HWApi) restoreBody(current *Configuration, snapshot *Configuration) ([]byte, error) {
+	if snapshot == nil {
+		snapshot = &Configuration{}
+	}
+	b, e := snapshot.MarshalWith(api.MarshalMode)
+	if e != nil {
+		return nil, e
+	}
+	m := map[string]json.RawMessage{}
+	if e := json.Unmarshal(b, &m); e != nil {
+		return nil, e
+	}
+	for _, p := range policies {
+		if _, ok := m[p.Name]; ok {
+			continue
+		}
+		v := policyValue(current, p)
+		switch {
+		case v.IsNil() || (p.Groupable && v.Len() == 0):
+		case p.Groupable:
+			m[p.Name] = json.RawMessage("[]")
+		default:
+			m[p.Name] = json.RawMessage("null")
+		}
+	}
+	return json.Marshal(m)
+}
+
+// MemorySnapshotStore keep snapshots in memory, lost when process exits
+type MemorySnapshotStore struct {
+	mu   sync.Mutex
+	list []*ConfigurationSnapshot
+}
+
+// Save snapshot
+func (m *MemorySnapshotStore) Save(s *ConfigurationSnapshot) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.list = append(m.list, s)
+	return nil
+}
+
+// List snapshots of scope, newest first
+func (m *MemorySnapshotStore) List(accountHash string, hostHash string, scopeID int) ([]*ConfigurationSnapshot, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	res := []*ConfigurationSnapshot{}
+	for i := len(m.list) - 1; i >= 0; i-- {
+		s := m.list[i]
+		if s.AccountHash == accountHash && s.HostHash == hostHash && s.ScopeID == scopeID {
+			res = append(res, s)
+		}
+	}
+	return res, nil
+}
+
+// Get snapshot by ID
+func (m *MemorySnapshotStore) Get(id string) (*ConfigurationSnapshot, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, s := range m.list {
+		if s.ID == id {
+			return s, nil
+		}
+	}
+	return nil, ErrSnapshotNotFound
+}
+
+// FileSnapshotStore save every snapshot as JSON file under Dir/accountHash/hostHash/scopeID/
+type FileSnapshotStore struct {
+	Dir string
+}
+
+func (f *FileSnapshotStore) scopeDir(accountHash string, hostHash string, scopeID int) string {
+	return filepath.Join(f.Dir, accountHash, hostHash, fmt.Sprintf("%d", scopeID))
+}
+
+// Save snapshot
+func (f *FileSnapshotStore) Save(s *ConfigurationSnapshot) error {
+	dir := f.scopeDir(s.AccountHash, s.HostHash, s.ScopeID)
+	if e := os.MkdirAll(dir, 0700); e != nil {
+		return e
+	}
+	b, e := json.Marshal(s)
+	if e != nil {
+		return e
+	}
+	return ioutil.WriteFile(filepath.Join(dir, s.ID+".json"), b, 0600)
+}
+
+func readSnapshot(path string) (*ConfigurationSnapshot, error) {
+	b, e := ioutil.ReadFile(path)
+	if e != nil {
+		return nil, e
+	}
+	s := &ConfigurationSnapshot{}
+	return s, json.Unmarshal(b, s)
+}
+
+// List snapshots of scope, newest first
+func (f *FileSnapshotStore) List(accountHash string, hostHash string, scopeID int) ([]*ConfigurationSnapshot, error) {
+	files, e := ioutil.ReadDir(f.scopeDir(accountHash, hostHash, scopeID))
+	if os.IsNotExist(e) {
+		return []*ConfigurationSnapshot{}, nil
+	}
+	if e != nil {
+		return nil, e
+	}
+	names := []string{}
+	for _, fi := range files {
+		if !fi.IsDir() && strings.HasSuffix(fi.Name(), ".json") {
+			names = append(names, fi.Name())
+		}
+	}
+	sort.Sort(sort.Reverse(sort.StringSlice(names)))
+	res := []*ConfigurationSnapshot{}
+	for _, n := range names {
+		s, e := readSnapshot(filepath.Join(f.scopeDir(accountHash, hostHash, scopeID), n))
+		if e != nil {
+			return nil, e
+		}
+		res = append(res, s)
+	}
+	return res, nil
+}
+
+// Get snapshot by ID
+func (f *FileSnapshotStore) Get(id string) (*ConfigurationSnapshot, error) {
+	matches, e := filepath.Glob(filepath.Join(f.Dir, "*", "*", "*", id+".json"))
+	if e != nil {
+		return nil, e
+	}
+	if len(matches) == 0 {
+		return nil, ErrSnapshotNotFound
+	}
+	return readSnapshot(matches[0])
+}
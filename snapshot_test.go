@@ -0,0 +1,168 @@
+package hwapi_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bucloud/hwapi"
+)
+
+func parseConfiguration(t *testing.T, s string) *hwapi.Configuration {
+	c := &hwapi.Configuration{}
+	if e := json.Unmarshal([]byte(s), c); e != nil {
+		t.Fatal(e)
+	}
+	return c
+}
+
+func testSnapshotStore(t *testing.T, store hwapi.SnapshotStore) {
+	now := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	for i, s := range []*hwapi.ConfigurationSnapshot{
+		{ID: "host-1-a", AccountHash: "acc", HostHash: "host", ScopeID: 1, CreatedAt: now, ReceiptID: "r1", Configuration: parseConfiguration(t, `{"originPullPolicy":[{"expireSeconds":60}]}`)},
+		{ID: "host-1-b", AccountHash: "acc", HostHash: "host", ScopeID: 1, CreatedAt: now.Add(time.Second), Configuration: parseConfiguration(t, `{"originPullPolicy":[{"expireSeconds":30}]}`)},
+		{ID: "host-2-a", AccountHash: "acc", HostHash: "host", ScopeID: 2, CreatedAt: now, Configuration: &hwapi.Configuration{}},
+	} {
+		if e := store.Save(s); e != nil {
+			t.Fatalf("save %d: %v", i, e)
+		}
+	}
+	l, e := store.List("acc", "host", 1)
+	if e != nil || len(l) != 2 || l[0].ID != "host-1-b" || l[1].ID != "host-1-a" {
+		t.Fatalf("got list %v %v", l, e)
+	}
+	if l, e := store.List("acc", "other", 1); e != nil || len(l) != 0 {
+		t.Errorf("expect empty list of unknown host, got %v %v", l, e)
+	}
+	s, e := store.Get("host-1-a")
+	if e != nil {
+		t.Fatal(e)
+	}
+	if s.ReceiptID != "r1" || !s.CreatedAt.Equal(now) || len(s.Configuration.OriginPullPolicy) != 1 || s.Configuration.OriginPullPolicy[0].ExpireSeconds != 60 {
+		t.Errorf("got snapshot %+v", s)
+	}
+	if _, e := store.Get("missing"); !errors.Is(e, hwapi.ErrSnapshotNotFound) {
+		t.Errorf("expect ErrSnapshotNotFound, got %v", e)
+	}
+}
+
+func TestMemorySnapshotStore(t *testing.T) {
+	testSnapshotStore(t, &hwapi.MemorySnapshotStore{})
+}
+
+func TestFileSnapshotStore(t *testing.T) {
+	dir, e := ioutil.TempDir("", "hwapi-snapshot")
+	if e != nil {
+		t.Fatal(e)
+	}
+	defer os.RemoveAll(dir)
+	testSnapshotStore(t, &hwapi.FileSnapshotStore{Dir: dir})
+	if _, e := os.Stat(dir + "/acc/host/1/host-1-a.json"); e != nil {
+		t.Error(e)
+	}
+}
+
+func TestRestoreSnapshot(t *testing.T) {
+	s := &scopeServer{gets: []string{`{"id":"receipt-0","scope":{"id":1},"originPullPolicy":[{"expireSeconds":60}]}`}}
+	api := newTestAPI(t, s)
+	if _, e := api.PreviewRestore("missing"); !errors.Is(e, hwapi.ErrSnapshotNotFound) {
+		t.Errorf("expect ErrSnapshotNotFound without store, got %v", e)
+	}
+	store := &hwapi.MemorySnapshotStore{}
+	api.SetSnapshotStore(store)
+	if _, e := api.UpdateConfiguration("acc", "host", 1, parseConfiguration(t, `{"originPullPolicy":[{"expireSeconds":30}],"compression":{"gzip":"txt"}}`)); e != nil {
+		t.Fatal(e)
+	}
+	l, _ := api.ListSnapshots("acc", "host", 1)
+	if len(l) != 1 || l[0].ReceiptID != "receipt-1" || l[0].Configuration.OriginPullPolicy[0].ExpireSeconds != 60 {
+		t.Fatalf("got snapshots %v", l)
+	}
+
+	changes, e := api.PreviewRestore(l[0].ID)
+	if e != nil {
+		t.Fatal(e)
+	}
+	got := changePaths(changes)
+	want := []string{"- compression", "~ originPullPolicy[0].expireSeconds: 30 => 60"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got preview %q, want %q", got, want)
+	}
+
+	c, e := api.RestoreSnapshot(l[0].ID)
+	if e != nil || c.ID != "receipt-2" || c.Compression != nil {
+		t.Fatalf("got %v %v", c, e)
+	}
+	// policy type added after snapshot is removed explicitly
+	if !strings.Contains(s.puts[1], `"compression":null`) {
+		t.Errorf("sent %s", s.puts[1])
+	}
+	if changes, _ := api.PreviewRestore(l[0].ID); len(changes) != 0 {
+		t.Errorf("expect nothing to restore, got %v", changes)
+	}
+	if l, _ := api.ListSnapshots("acc", "host", 1); len(l) != 2 || l[0].ReceiptID != "receipt-2" || l[0].Configuration.Compression == nil {
+		t.Errorf("expect configuration replaced by restore snapshotted, got %v", l)
+	}
+}
+
+var errStoreDown = errors.New("store down")
+
+type failingSnapshotStore struct {
+	hwapi.MemorySnapshotStore
+}
+
+func (*failingSnapshotStore) Save(*hwapi.ConfigurationSnapshot) error {
+	return errStoreDown
+}
+
+func TestSnapshotSaveError(t *testing.T) {
+	api := newTestAPI(t, &scopeServer{gets: []string{`{"scope":{"id":1}}`}})
+	api.SetSnapshotStore(&failingSnapshotStore{})
+	c, e := api.UpdateConfiguration("acc", "host", 1, &hwapi.Configuration{})
+	if !errors.Is(e, errStoreDown) || c == nil || c.ID != "receipt-1" {
+		t.Errorf("expect updated configuration with wrapped store error, got %v %v", c, e)
+	}
+}
+
+// changePaths kind and path of each change, values are kept for changed scalars only
+func changePaths(changes []*hwapi.ConfigurationChange) []string {
+	res := []string{}
+	for _, c := range changes {
+		r := c.Reveal()
+		if c.Old == nil || c.New == nil {
+			r = strings.SplitN(r, ":", 2)[0]
+		}
+		res = append(res, r)
+	}
+	return res
+}
+
+func TestDiffConfiguration(t *testing.T) {
+	for _, c := range []struct {
+		src, dst string
+		want     []string
+	}{
+		{`{"id":"a","scope":{"id":1}}`, `{"id":"b","scope":{"id":2}}`, []string{}},
+		{`{}`, `{"originPullPolicy":[{"expireSeconds":60}]}`, []string{"+ originPullPolicy"}},
+		{`{"originPullPolicy":[{"expireSeconds":60}]}`, `{"originPullPolicy":[{"expireSeconds":60},{"expireSeconds":5,"pathFilter":"*.ts"}]}`, []string{"+ originPullPolicy[1]"}},
+		{`{"originPullPolicy":[{"expireSeconds":60},{"expireSeconds":5}]}`, `{"originPullPolicy":[{"expireSeconds":60}]}`, []string{"- originPullPolicy[1]"}},
+		// omitted pathFilter is defaulted to *
+		{`{"originPullPolicy":[{"expireSeconds":60,"pathFilter":"*.ts"}]}`, `{"originPullPolicy":[{"expireSeconds":60}]}`, []string{`~ originPullPolicy[0].pathFilter: "*.ts" => "*"`}},
+		{`{"authUrlSign":[{"passPhrase":"old"}]}`, `{"authUrlSign":[{"passPhrase":"new"}]}`, []string{`~ authUrlSign[0].passPhrase: "old" => "new"`}},
+	} {
+		changes, e := hwapi.DiffConfiguration(parseConfiguration(t, c.src), parseConfiguration(t, c.dst))
+		if e != nil {
+			t.Fatal(e)
+		}
+		if got := changePaths(changes); !reflect.DeepEqual(got, c.want) {
+			t.Errorf("%s => %s: got %q, want %q", c.src, c.dst, got, c.want)
+		}
+	}
+	if changes, e := hwapi.DiffConfiguration(nil, &hwapi.Configuration{}); e != nil || len(changes) != 0 {
+		t.Errorf("expect nil diff as empty, got %v %v", changes, e)
+	}
+}
@@ -0,0 +1,195 @@
+package hwapi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// ErrTemplateVariable placeholder in template has no value
+var ErrTemplateVariable = errors.New("template variable not defined")
+
+var templatePlaceholder = regexp.MustCompile(`\{\{\s*([A-Za-z_][A-Za-z0-9_]*)\s*\}\}`)
+
+// ConfigurationTemplate partial configuration shared by many hosts
+// Any string value could contain placeholders such like {{originHostname}}, which are replaced by variables of each host.
+// Policy types defined in template replace the same types in host scope, other types are kept as is.
+type ConfigurationTemplate struct {
+	Name          string
+	Configuration *Configuration
+	// Vars default variables, overridden by TemplateTarget.Vars
+	Vars map[string]string
+}
+
+// TemplateTarget host scope a template is applied to
+type TemplateTarget struct {
+	AccountHash string
+	HostHash    string
+	ScopeID     int
+	// Vars variables of this host
+	Vars map[string]string
+	// Override policies applied after template, same policy types in template are replaced
+	Override *Configuration
+}
+
+// TemplateResult result of a single target
+type TemplateResult struct {
+	Target *TemplateTarget
+	// Configuration rendered configuration to be sent or returned by API after apply
+	Configuration *Configuration
+	// Changes from current configuration to rendered one
+	Changes []*ConfigurationChange
+	Err     error
+}
+
+// Variables list placeholders used in template, sorted
+func (t *ConfigurationTemplate) Variables() ([]string, error) {
+	b, e := json.Marshal(t.Configuration)
+	if e != nil {
+		return nil, e
+	}
+	names := map[string]bool{}
+	for _, m := range templatePlaceholder.FindAllStringSubmatch(string(b), -1) {
+		names[m[1]] = true
+	}
+	res := []string{}
+	for n := range names {
+		res = append(res, n)
+	}
+	sort.Strings(res)
+	return res, nil
+}
+
+// Render replace placeholders with vars, template is not modified
+func (t *ConfigurationTemplate) Render(vars map[string]string) (*Configuration, error) {
+	all := map[string]string{}
+	for k, v := range t.Vars {
+		all[k] = v
+	}
+	for k, v := range vars {
+		all[k] = v
+	}
+	b, e := json.Marshal(t.Configuration)
+	if e != nil {
+		return nil, e
+	}
+	var doc interface{}
+	if e := json.Unmarshal(b, &doc); e != nil {
+		return nil, e
+	}
+	missing := map[string]bool{}
+	doc = renderTemplateValue(doc, all, missing)
+	if len(missing) > 0 {
+		names := []string{}
+		for n := range missing {
+			names = append(names, n)
+		}
+		sort.Strings(names)
+		return nil, fmt.Errorf("%w: %s", ErrTemplateVariable, strings.Join(names, ", "))
+	}
+	if b, e = json.Marshal(doc); e != nil {
+		return nil, e
+	}
+	c := &Configuration{}
+	return c, json.Unmarshal(b, c)
+}
+
+func renderTemplateValue(v interface{}, vars map[string]string, missing map[string]bool) interface{} {
+	switch x := v.(type) {
+	case string:
+		return templatePlaceholder.ReplaceAllStringFunc(x, func(s string) string {
+			n := templatePlaceholder.FindStringSubmatch(s)[1]
+			r, ok := vars[n]
+			if !ok {
+				missing[n] = true
+			}
+			return r
+		})
+	case map[string]interface{}:
+		for k, e := range x {
+			x[k] = renderTemplateValue(e, vars, missing)
+		}
+	case []interface{}:
+		for i, e := range x {
+			x[i] = renderTemplateValue(e, vars, missing)
+		}
+	}
+	return v
+}
+
+// renderTarget load current configuration of target and merge rendered template into it
+func (api *HWApi) renderTarget(t *ConfigurationTemplate, target *TemplateTarget) *TemplateResult {
+	res := &TemplateResult{Target: target}
+	rendered, e := t.Render(target.Vars)
+	if e != nil {
+		res.Err = e
+		return res
+	}
+	current, e := api.GetConfiguration(target.AccountHash, target.HostHash, target.ScopeID)
+	if e != nil {
+		res.Err = e
+		return res
+	}
+	merged := MergeConfiguration(current, rendered, target.Override).Configuration
+	merged.Scope = current.Scope
+	res.Configuration = merged
+	res.Changes, res.Err = DiffConfiguration(current, merged)
+	return res
+}
+
+// eachTarget run fn for every target with at most api.workers goroutines, results are ordered as targets
+func (api *HWApi) eachTarget(targets []*TemplateTarget, fn func(target *TemplateTarget) *TemplateResult) []*TemplateResult {
+	res := make([]*TemplateResult, len(targets))
+	n := api.workers
+	if n < 1 {
+		n = 1
+	}
+	sem := make(chan struct{}, n)
+	wg := sync.WaitGroup{}
+	for i, target := range targets {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, target *TemplateTarget) {
+			defer wg.Done()
+			res[i] = fn(target)
+			<-sem
+		}(i, target)
+	}
+	wg.Wait()
+	return res
+}
+
+// PreviewTemplate render template for every target and diff against its current configuration, nothing is updated
+func (api *HWApi) PreviewTemplate(t *ConfigurationTemplate, targets []*TemplateTarget) []*TemplateResult {
+	return api.eachTarget(targets, func(target *TemplateTarget) *TemplateResult {
+		return api.renderTarget(t, target)
+	})
+}
+
+// ApplyTemplate render template and update every target, failure of one target doesn't stop others
+// Targets without any change are skipped, use TemplateResult.Err to check result of each host
+func (api *HWApi) ApplyTemplate(t *ConfigurationTemplate, targets []*TemplateTarget) []*TemplateResult {
+	return api.eachTarget(targets, func(target *TemplateTarget) *TemplateResult {
+		res := api.renderTarget(t, target)
+		if res.Err != nil || len(res.Changes) == 0 {
+			return res
+		}
+		c, e := api.UpdateConfiguration(target.AccountHash, target.HostHash, target.ScopeID, res.Configuration)
+		if c != nil {
+			res.Configuration = c
+		}
+		res.Err = e
+		if api.Log != nil {
+			ev := api.Log.Info()
+			if e != nil {
+				ev = api.Log.Error().Err(e)
+			}
+			ev.Str("template", t.Name).Str("hostHash", target.HostHash).Int("scopeID", target.ScopeID).Int("changes", len(res.Changes)).Msg("apply configuration template")
+		}
+		return res
+	})
+}
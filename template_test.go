@@ -0,0 +1,125 @@
+package hwapi_test
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/bucloud/hwapi"
+)
+
+// hostsServer scope 1 configuration of each host, hosts without configuration respond 500
+type hostsServer struct {
+	sync.Mutex
+	conf map[string]string
+	puts map[string]string
+}
+
+func (s *hostsServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.Lock()
+	defer s.Unlock()
+	// /api/v1/accounts/{account}/hosts/{host}/configuration/{scope}
+	host := strings.Split(r.URL.Path, "/")[6]
+	c, ok := s.conf[host]
+	if !ok {
+		http.Error(w, `{"error":"host unavailable"}`, http.StatusInternalServerError)
+		return
+	}
+	if r.Method == http.MethodPut {
+		b, _ := ioutil.ReadAll(r.Body)
+		s.puts[host] = string(b)
+		fmt.Fprintf(w, `{"id":"receipt-%s"}`, host)
+		return
+	}
+	w.Write([]byte(c))
+}
+
+func newTestTemplate(t *testing.T) *hwapi.ConfigurationTemplate {
+	return &hwapi.ConfigurationTemplate{
+		Name:          "origin",
+		Configuration: parseConfiguration(t, `{"originPullHost":{"primary":1,"path":"/{{ site }}/{{env}}"},"originPullPolicy":[{"expireSeconds":60,"pathFilter":"/{{site}}/*"}]}`),
+		Vars:          map[string]string{"env": "prod"},
+	}
+}
+
+func TestTemplateRender(t *testing.T) {
+	tpl := newTestTemplate(t)
+	if v, e := tpl.Variables(); e != nil || !reflect.DeepEqual(v, []string{"env", "site"}) {
+		t.Errorf("got variables %v %v", v, e)
+	}
+
+	c, e := tpl.Render(map[string]string{"site": "a", "env": "dev"})
+	if e != nil {
+		t.Fatal(e)
+	}
+	if c.OriginPullHost.Path != "/a/dev" || c.OriginPullPolicy[0].PathFilter != "/a/*" {
+		t.Errorf("got path %s pathFilter %s", c.OriginPullHost.Path, c.OriginPullPolicy[0].PathFilter)
+	}
+	if c, e := tpl.Render(map[string]string{"site": "b"}); e != nil || c.OriginPullHost.Path != "/b/prod" {
+		t.Errorf("expect default env, got %v %v", c, e)
+	}
+	if tpl.Configuration.OriginPullHost.Path != "/{{ site }}/{{env}}" {
+		t.Errorf("template modified: %s", tpl.Configuration.OriginPullHost.Path)
+	}
+
+	tpl.Configuration.OriginPullPolicy[0].PathFilter = "{{site}}-{{region}}-{{zone}}"
+	_, e = tpl.Render(nil)
+	if !errors.Is(e, hwapi.ErrTemplateVariable) || !strings.HasSuffix(e.Error(), ": region, site, zone") {
+		t.Errorf("expect ErrTemplateVariable listing missing variables, got %v", e)
+	}
+}
+
+func TestApplyTemplate(t *testing.T) {
+	s := &hostsServer{
+		conf: map[string]string{
+			"h1": `{"scope":{"id":1},"originPullHost":{"primary":1,"path":"/old"},"compression":{"gzip":"txt"}}`,
+			"h2": `{"scope":{"id":1},"originPullHost":{"primary":1,"path":"/b/prod"},"originPullPolicy":[{"expireSeconds":60,"pathFilter":"/b/*"}]}`,
+		},
+		puts: map[string]string{},
+	}
+	api := newTestAPI(t, s, 3)
+	targets := []*hwapi.TemplateTarget{
+		{AccountHash: "acc", HostHash: "h1", ScopeID: 1, Vars: map[string]string{"site": "a"}},
+		{AccountHash: "acc", HostHash: "broken", ScopeID: 1, Vars: map[string]string{"site": "x"}},
+		{AccountHash: "acc", HostHash: "h3", ScopeID: 1},
+		{AccountHash: "acc", HostHash: "h2", ScopeID: 1, Vars: map[string]string{"site": "b"}},
+	}
+
+	preview := api.PreviewTemplate(newTestTemplate(t), targets)
+	if len(s.puts) != 0 {
+		t.Errorf("preview updated %v", s.puts)
+	}
+	if len(preview[0].Changes) == 0 || preview[0].Configuration.Compression == nil || preview[0].Configuration.OriginPullHost.Path != "/a/prod" {
+		t.Errorf("got preview of h1 %+v", preview[0])
+	}
+
+	res := api.ApplyTemplate(newTestTemplate(t), targets)
+	if len(res) != len(targets) {
+		t.Fatalf("got %d results", len(res))
+	}
+	for i, r := range res {
+		if r.Target != targets[i] {
+			t.Errorf("result %d is of %s", i, r.Target.HostHash)
+		}
+	}
+	if res[0].Err != nil || res[0].Configuration.ID != "receipt-h1" || !strings.Contains(s.puts["h1"], `"/a/prod"`) || !strings.Contains(s.puts["h1"], `"compression"`) {
+		t.Errorf("got h1 %+v, put %s", res[0], s.puts["h1"])
+	}
+	if res[1].Err == nil || res[1].Configuration != nil {
+		t.Errorf("expect error of broken host, got %+v", res[1])
+	}
+	if !errors.Is(res[2].Err, hwapi.ErrTemplateVariable) {
+		t.Errorf("expect ErrTemplateVariable of h3, got %v", res[2].Err)
+	}
+	if res[3].Err != nil || len(res[3].Changes) != 0 {
+		t.Errorf("expect h2 unchanged, got %+v", res[3])
+	}
+	if _, ok := s.puts["h2"]; ok || len(s.puts) != 1 {
+		t.Errorf("expect only h1 updated, got %v", s.puts)
+	}
+}
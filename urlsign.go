@@ -0,0 +1,258 @@
+package hwapi
+
+import (
+	"crypto/md5"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrSignatureInvalid signature is missing or doesn't match URL
+	ErrSignatureInvalid = errors.New("invalid signature")
+	// ErrSignatureExpired signature is valid but URL expired
+	ErrSignatureExpired = errors.New("signature expired")
+	// ErrSignatureNotStarted signature is valid but URL is used before start time
+	ErrSignatureNotStarted = errors.New("signature not started")
+	// ErrSignatureClient URL is bound to another IP address or user agent
+	ErrSignatureClient = errors.New("signature bound to other client")
+	// ErrSignerPolicy policy can't been used to sign URLs
+	ErrSignerPolicy = errors.New("invalid signer policy")
+)
+
+// SignOptions restrictions embedded in signed URL, zero values are not embedded
+type SignOptions struct {
+	// Start time before which URL is invalid
+	Start time.Time
+	// Expires time after which URL is invalid
+	Expires time.Time
+	// IP client IP address URL is bound to
+	IP string
+	// UserAgent client User-Agent URL is bound to
+	UserAgent string
+	// URILength bytes of path covered by signature, nil means whole path
+	URILength *int
+}
+
+// VerifyRequest client requesting signed URL
+type VerifyRequest struct {
+	IP        string
+	UserAgent string
+	// Header request headers, used by token in cookie or header and extracted components
+	Header http.Header
+	// Now time compared with expiry, default time.Now()
+	Now time.Time
+}
+
+func (r *VerifyRequest) now() time.Time {
+	if r == nil || r.Now.IsZero() {
+		return time.Now()
+	}
+	return r.Now
+}
+
+// Signer sign URLs the way edge validates them, Verify is the edge side check, used by test suites
+type Signer interface {
+	Sign(rawURL string, opt *SignOptions) (string, error)
+	Verify(rawURL string, req *VerifyRequest) error
+}
+
+// queryParam single name=value of raw query, order and encoding are kept
+type queryParam struct {
+	raw   string
+	name  string
+	value string
+}
+
+func parseRawQuery(q string) []*queryParam {
+	res := []*queryParam{}
+	for _, p := range strings.Split(q, "&") {
+		if p == "" {
+			continue
+		}
+		kv := strings.SplitN(p, "=", 2)
+		name, _ := url.QueryUnescape(kv[0])
+		value := ""
+		if len(kv) == 2 {
+			value, _ = url.QueryUnescape(kv[1])
+		}
+		res = append(res, &queryParam{raw: p, name: name, value: value})
+	}
+	return res
+}
+
+func joinRawQuery(params []*queryParam) string {
+	l := make([]string, 0, len(params))
+	for _, p := range params {
+		l = append(l, p.raw)
+	}
+	return strings.Join(l, "&")
+}
+
+func newQueryParam(name string, value string) *queryParam {
+	return &queryParam{raw: url.QueryEscape(name) + "=" + url.QueryEscape(value), name: name, value: value}
+}
+
+// withoutParams remove params by name, empty names are ignored
+func withoutParams(params []*queryParam, names ...string) []*queryParam {
+	res := []*queryParam{}
+	for _, p := range params {
+		drop := false
+		for _, n := range names {
+			drop = drop || (n != "" && p.name == n)
+		}
+		if !drop {
+			res = append(res, p)
+		}
+	}
+	return res
+}
+
+func findParam(params []*queryParam, name string) (int, *queryParam) {
+	if name == "" {
+		return -1, nil
+	}
+	for i, p := range params {
+		if p.name == name {
+			return i, p
+		}
+	}
+	return -1, nil
+}
+
+// AuthURLSigner sign URLs validated by authUrlSign
+//
+// Token is MD5 hex of path?query&passPhraseField=passPhrase, where query is every parameter before token,
+// with userAgentField set to User-Agent of request. If uriLengthField is present only first N bytes of path
+// after leading "/" are signed, 0 means directory of path with trailing "/".
+type AuthURLSigner struct {
+	Policy *AuthURLSign
+}
+
+// NewAuthURLSigner create signer of authUrlSign policy, tokenField, passPhraseField and passPhrase are required
+func NewAuthURLSigner(p *AuthURLSign) (*AuthURLSigner, error) {
+	switch {
+	case p == nil:
+		return nil, fmt.Errorf("%w: authUrlSign is nil", ErrSignerPolicy)
+	case p.TokenField == "":
+		return nil, fmt.Errorf("%w: authUrlSign tokenField is required", ErrSignerPolicy)
+	case p.PassPhraseField == "":
+		return nil, fmt.Errorf("%w: authUrlSign passPhraseField is required", ErrSignerPolicy)
+	case p.PassPhrase == "":
+		return nil, fmt.Errorf("%w: authUrlSign passPhrase is required", ErrSignerPolicy)
+	}
+	return &AuthURLSigner{Policy: p}, nil
+}
+
+// Sign add expires, IP, user agent and URI length fields configured by policy and token to URL
+// Restrictions not configured by policy are ignored, existing token and passphrase are removed
+func (s *AuthURLSigner) Sign(rawURL string, opt *SignOptions) (string, error) {
+	if opt == nil {
+		opt = &SignOptions{}
+	}
+	u, e := url.Parse(rawURL)
+	if e != nil {
+		return "", e
+	}
+	p := s.Policy
+	params := withoutParams(parseRawQuery(u.RawQuery), p.TokenField, p.PassPhraseField, p.ExpiresField, p.IPAddressField, p.UserAgentField, p.URILengthField)
+	if p.ExpiresField != "" && !opt.Expires.IsZero() {
+		params = append(params, newQueryParam(p.ExpiresField, strconv.FormatInt(opt.Expires.Unix(), 10)))
+	}
+	if p.IPAddressField != "" && opt.IP != "" {
+		params = append(params, newQueryParam(p.IPAddressField, opt.IP))
+	}
+	if p.URILengthField != "" && opt.URILength != nil {
+		params = append(params, newQueryParam(p.URILengthField, strconv.Itoa(*opt.URILength)))
+	}
+	if p.UserAgentField != "" && opt.UserAgent != "" {
+		params = append(params, newQueryParam(p.UserAgentField, ""))
+	}
+	token, e := s.token(u.EscapedPath(), params, opt.UserAgent)
+	if e != nil {
+		return "", e
+	}
+	u.RawQuery = joinRawQuery(append(params, newQueryParam(p.TokenField, token)))
+	return u.String(), nil
+}
+
+// token MD5 of signed part of URL, params are the ones before token
+func (s *AuthURLSigner) token(path string, params []*queryParam, userAgent string) (string, error) {
+	p := s.Policy
+	if path == "" {
+		path = "/"
+	}
+	if _, l := findParam(params, p.URILengthField); l != nil {
+		n, e := strconv.Atoi(l.value)
+		if e != nil || n < 0 {
+			return "", fmt.Errorf("%w: invalid %s %q", ErrSignatureInvalid, p.URILengthField, l.value)
+		}
+		path = strings.TrimPrefix(path, "/")
+		switch {
+		case n == 0:
+			path = path[:strings.LastIndex(path, "/")+1]
+		case n < len(path):
+			path = path[:n]
+		}
+	}
+	signed := make([]*queryParam, 0, len(params)+1)
+	for _, q := range params {
+		if p.UserAgentField != "" && q.name == p.UserAgentField {
+			q = newQueryParam(q.name, userAgent)
+		}
+		signed = append(signed, q)
+	}
+	signed = append(signed, newQueryParam(p.PassPhraseField, p.PassPhrase))
+	sum := md5.Sum([]byte(path + "?" + joinRawQuery(signed)))
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// Verify check URL the way edge does
+func (s *AuthURLSigner) Verify(rawURL string, req *VerifyRequest) error {
+	if req == nil {
+		req = &VerifyRequest{}
+	}
+	u, e := url.Parse(rawURL)
+	if e != nil {
+		return e
+	}
+	p := s.Policy
+	params := parseRawQuery(u.RawQuery)
+	i, t := findParam(params, p.TokenField)
+	if t == nil {
+		return fmt.Errorf("%w: %s is missing", ErrSignatureInvalid, p.TokenField)
+	}
+	signed := append([]*queryParam{}, params[:i]...)
+	if !boolValue(p.IgnoreFieldsAfterToken) {
+		signed = append(signed, params[i+1:]...)
+	}
+	if _, pp := findParam(signed, p.PassPhraseField); pp != nil {
+		return fmt.Errorf("%w: %s must not be sent", ErrSignatureInvalid, p.PassPhraseField)
+	}
+	token, e := s.token(u.EscapedPath(), signed, req.UserAgent)
+	if e != nil {
+		return e
+	}
+	if subtle.ConstantTimeCompare([]byte(strings.ToLower(t.value)), []byte(token)) != 1 {
+		return fmt.Errorf("%w: %s doesn't match", ErrSignatureInvalid, p.TokenField)
+	}
+	if _, ex := findParam(signed, p.ExpiresField); ex != nil {
+		n, e := strconv.ParseInt(ex.value, 10, 64)
+		if e != nil {
+			return fmt.Errorf("%w: invalid %s %q", ErrSignatureInvalid, p.ExpiresField, ex.value)
+		}
+		if req.now().Unix() > n {
+			return fmt.Errorf("%w: at %s", ErrSignatureExpired, time.Unix(n, 0).UTC().Format(time.RFC3339))
+		}
+	}
+	if _, ip := findParam(signed, p.IPAddressField); ip != nil && ip.value != req.IP {
+		return fmt.Errorf("%w: IP %s", ErrSignatureClient, ip.value)
+	}
+	return nil
+}
@@ -0,0 +1,417 @@
+package hwapi
+
+import (
+	"crypto/hmac"
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// AKv2 hash strategies
+const (
+	HashSHA1   = "sha1"
+	HashSHA256 = "sha256"
+	HashMD5    = "md5"
+)
+
+// boolDefault value of optional flag, def if nil
+func boolDefault(b *bool, def bool) bool {
+	if b == nil {
+		return def
+	}
+	return *b
+}
+
+func stringDefault(s string, def string) string {
+	if s == "" {
+		return def
+	}
+	return s
+}
+
+// AKv1Signer sign URLs validated by authUrlSignAKv1
+//
+// Token is expires_hash, where expires is unix time and hash is MD5 hex of
+// salt + MD5(expires + path + param + extracted value + salt), expires is hashed as
+// 4 bytes little endian like urlauth_gen_token of Akamai reference code
+type AKv1Signer struct {
+	Policy *AuthURLSignAKv1
+}
+
+// NewAKv1Signer create signer of authUrlSignAKv1 policy, salt is required
+func NewAKv1Signer(p *AuthURLSignAKv1) (*AKv1Signer, error) {
+	switch {
+	case p == nil:
+		return nil, fmt.Errorf("%w: authUrlSignAKv1 is nil", ErrSignerPolicy)
+	case p.Salt == "":
+		return nil, fmt.Errorf("%w: authUrlSignAKv1 salt is required", ErrSignerPolicy)
+	case p.Extract != "" && !strings.HasPrefix(strings.ToLower(p.Extract), "header:"):
+		return nil, fmt.Errorf("%w: authUrlSignAKv1 extract %q, only header is supported", ErrSignerPolicy, p.Extract)
+	}
+	return &AKv1Signer{Policy: p}, nil
+}
+
+func (s *AKv1Signer) param() string {
+	return stringDefault(s.Policy.Param, "__gda__")
+}
+
+// extractHeader name of header extracted by policy, empty if none
+func (s *AKv1Signer) extractHeader() string {
+	if s.Policy.Extract == "" {
+		return ""
+	}
+	return strings.TrimSpace(s.Policy.Extract[strings.Index(s.Policy.Extract, ":")+1:])
+}
+
+func (s *AKv1Signer) hash(expires int64, path string, extracted string) string {
+	b := make([]byte, 4, 4+len(path)+len(s.param())+len(extracted)+len(s.Policy.Salt))
+	binary.LittleEndian.PutUint32(b, uint32(expires))
+	inner := md5.Sum(append(b, path+s.param()+extracted+s.Policy.Salt...))
+	outer := md5.Sum(append([]byte(s.Policy.Salt), inner[:]...))
+	return hex.EncodeToString(outer[:])
+}
+
+// Token value of param for path, extracted is value of extracted header
+func (s *AKv1Signer) Token(path string, expires time.Time, extracted string) string {
+	return strconv.FormatInt(expires.Unix(), 10) + "_" + s.hash(expires.Unix(), path, extracted)
+}
+
+// Sign add param to URL, Expires is required, value of extracted header is passed as UserAgent
+// if extract is header:User-Agent, otherwise use Token directly
+func (s *AKv1Signer) Sign(rawURL string, opt *SignOptions) (string, error) {
+	if opt == nil || opt.Expires.IsZero() {
+		return "", fmt.Errorf("%w: expire time is required", ErrSignerPolicy)
+	}
+	u, e := url.Parse(rawURL)
+	if e != nil {
+		return "", e
+	}
+	extracted := ""
+	if h := s.extractHeader(); h != "" {
+		if !strings.EqualFold(h, "User-Agent") {
+			return "", fmt.Errorf("%w: extract %s, use Token with header value", ErrSignerPolicy, s.Policy.Extract)
+		}
+		extracted = opt.UserAgent
+	}
+	params := withoutParams(parseRawQuery(u.RawQuery), s.param())
+	u.RawQuery = joinRawQuery(append(params, newQueryParam(s.param(), s.Token(u.EscapedPath(), opt.Expires, extracted))))
+	return u.String(), nil
+}
+
+// Verify check URL the way edge does, extracted header is read from req.Header, or UserAgent
+func (s *AKv1Signer) Verify(rawURL string, req *VerifyRequest) error {
+	if req == nil {
+		req = &VerifyRequest{}
+	}
+	u, e := url.Parse(rawURL)
+	if e != nil {
+		return e
+	}
+	_, t := findParam(parseRawQuery(u.RawQuery), s.param())
+	if t == nil {
+		return fmt.Errorf("%w: %s is missing", ErrSignatureInvalid, s.param())
+	}
+	i := strings.Index(t.value, "_")
+	if i < 0 {
+		return fmt.Errorf("%w: %s is malformed", ErrSignatureInvalid, s.param())
+	}
+	exp, e := strconv.ParseInt(t.value[:i], 10, 64)
+	if e != nil {
+		return fmt.Errorf("%w: invalid expire time %q", ErrSignatureInvalid, t.value[:i])
+	}
+	extracted := ""
+	if h := s.extractHeader(); h != "" {
+		vs, ok := req.Header[http.CanonicalHeaderKey(h)]
+		if !ok && strings.EqualFold(h, "User-Agent") && req.UserAgent != "" {
+			vs, ok = []string{req.UserAgent}, true
+		}
+		if !ok {
+			return fmt.Errorf("%w: header %s is required by extract", ErrSignatureClient, h)
+		}
+		extracted = strings.Join(vs, ",")
+	}
+	if subtle.ConstantTimeCompare([]byte(strings.ToLower(t.value[i+1:])), []byte(s.hash(exp, u.EscapedPath(), extracted))) != 1 {
+		return fmt.Errorf("%w: %s doesn't match", ErrSignatureInvalid, s.param())
+	}
+	if req.now().Unix() > exp {
+		return fmt.Errorf("%w: at %s", ErrSignatureExpired, time.Unix(exp, 0).UTC().Format(time.RFC3339))
+	}
+	return nil
+}
+
+// AKToken fields of AKv2 token, zero values are omitted
+type AKToken struct {
+	// ACL paths token is valid for, joined by aclDelimiter, used when matchURL is false
+	ACL []string
+	// URL path token is valid for, used when matchURL is true, hashed but not sent
+	URL     string
+	IP      string
+	Start   time.Time
+	Expires time.Time
+	// ID session ID
+	ID string
+	// Data opaque payload
+	Data string
+}
+
+// AKv2Signer generate tokens validated by authUrlSignAKv2, compatible with Akamai EdgeAuth tokens
+//
+// Token is ip=~st=~exp=~acl=~id=~data=~hmac= joined by fieldDelimiter, hmac is HMAC of the fields
+// before it followed by url= if matchURL and salt= if salt, keyed by hex decoded passPhrase
+// using hashStrategy. Token is sent in query string, cookie or header named by first tokenField.
+type AKv2Signer struct {
+	Policy *AuthURLSignAKv2
+
+	key []byte
+}
+
+// NewAKv2Signer create signer of authUrlSignAKv2 policy, passPhrase must be hex
+func NewAKv2Signer(p *AuthURLSignAKv2) (*AKv2Signer, error) {
+	if p == nil {
+		return nil, fmt.Errorf("%w: authUrlSignAKv2 is nil", ErrSignerPolicy)
+	}
+	key, e := hex.DecodeString(p.PassPhrase)
+	if e != nil || len(key) == 0 {
+		return nil, fmt.Errorf("%w: authUrlSignAKv2 passPhrase must be hex padded to a byte boundary", ErrSignerPolicy)
+	}
+	s := &AKv2Signer{Policy: p, key: key}
+	if _, e := s.hashFunc(); e != nil {
+		return nil, e
+	}
+	return s, nil
+}
+
+func (s *AKv2Signer) hashFunc() (func() hash.Hash, error) {
+	switch strings.ToLower(stringDefault(s.Policy.HashStrategy, HashSHA256)) {
+	case HashSHA256:
+		return sha256.New, nil
+	case HashSHA1:
+		return sha1.New, nil
+	case HashMD5:
+		return md5.New, nil
+	}
+	return nil, fmt.Errorf("%w: authUrlSignAKv2 unknown hashStrategy %q", ErrSignerPolicy, s.Policy.HashStrategy)
+}
+
+func (s *AKv2Signer) fieldDelimiter() string {
+	return stringDefault(s.Policy.FieldDelimiter, "~")
+}
+
+func (s *AKv2Signer) aclDelimiter() string {
+	return stringDefault(s.Policy.ACLDelimiter, "!")
+}
+
+// TokenNames names of query parameter, cookie or header carrying token, first one is used to sign
+func (s *AKv2Signer) TokenNames() []string {
+	res := []string{}
+	for _, n := range strings.Split(stringDefault(s.Policy.TokenField, "hdntl"), ",") {
+		if n = strings.TrimSpace(n); n != "" {
+			res = append(res, n)
+		}
+	}
+	return res
+}
+
+func (s *AKv2Signer) hmac(fields []string, path string) string {
+	src := append([]string{}, fields...)
+	if boolDefault(s.Policy.MatchURL, true) {
+		src = append(src, "url="+path)
+	}
+	if s.Policy.Salt != "" {
+		src = append(src, "salt="+s.Policy.Salt)
+	}
+	h, _ := s.hashFunc()
+	m := hmac.New(h, s.key)
+	m.Write([]byte(strings.Join(src, s.fieldDelimiter())))
+	return hex.EncodeToString(m.Sum(nil))
+}
+
+// Token generate token value, Expires is required, URL is required with matchURL, ACL otherwise
+func (s *AKv2Signer) Token(t *AKToken) (string, error) {
+	matchURL := boolDefault(s.Policy.MatchURL, true)
+	switch {
+	case t.Expires.IsZero():
+		return "", fmt.Errorf("%w: expire time is required", ErrSignerPolicy)
+	case matchURL && t.URL == "":
+		return "", fmt.Errorf("%w: URL is required by matchURL", ErrSignerPolicy)
+	case !matchURL && len(t.ACL) == 0:
+		return "", fmt.Errorf("%w: ACL is required without matchURL", ErrSignerPolicy)
+	}
+	fields := []string{}
+	if t.IP != "" {
+		fields = append(fields, "ip="+t.IP)
+	}
+	if !t.Start.IsZero() {
+		fields = append(fields, "st="+strconv.FormatInt(t.Start.Unix(), 10))
+	}
+	fields = append(fields, "exp="+strconv.FormatInt(t.Expires.Unix(), 10))
+	if !matchURL {
+		fields = append(fields, "acl="+strings.Join(t.ACL, s.aclDelimiter()))
+	}
+	if t.ID != "" {
+		fields = append(fields, "id="+t.ID)
+	}
+	if t.Data != "" {
+		fields = append(fields, "data="+t.Data)
+	}
+	return strings.Join(append(fields, "hmac="+s.hmac(fields, t.URL)), s.fieldDelimiter()), nil
+}
+
+// Cookie token in cookie form
+func (s *AKv2Signer) Cookie(t *AKToken) (*http.Cookie, error) {
+	v, e := s.Token(t)
+	if e != nil {
+		return nil, e
+	}
+	return &http.Cookie{Name: s.TokenNames()[0], Value: v, Path: "/", Expires: t.Expires}, nil
+}
+
+// Header token in header form
+func (s *AKv2Signer) Header(t *AKToken) (http.Header, error) {
+	v, e := s.Token(t)
+	if e != nil {
+		return nil, e
+	}
+	return http.Header{http.CanonicalHeaderKey(s.TokenNames()[0]): {v}}, nil
+}
+
+// Sign add token of URL path to query string, path is used as ACL without matchURL
+func (s *AKv2Signer) Sign(rawURL string, opt *SignOptions) (string, error) {
+	if opt == nil {
+		opt = &SignOptions{}
+	}
+	u, e := url.Parse(rawURL)
+	if e != nil {
+		return "", e
+	}
+	path := u.EscapedPath()
+	t, e := s.Token(&AKToken{URL: path, ACL: []string{path}, IP: opt.IP, Start: opt.Start, Expires: opt.Expires})
+	if e != nil {
+		return "", e
+	}
+	name := s.TokenNames()[0]
+	u.RawQuery = joinRawQuery(append(withoutParams(parseRawQuery(u.RawQuery), name), newQueryParam(name, t)))
+	return u.String(), nil
+}
+
+// findToken token of request from query string, cookie or header
+func (s *AKv2Signer) findToken(u *url.URL, h http.Header) string {
+	params := parseRawQuery(u.RawQuery)
+	req := &http.Request{Header: h}
+	for _, n := range s.TokenNames() {
+		if _, p := findParam(params, n); p != nil {
+			return p.value
+		}
+		if c, e := req.Cookie(n); e == nil {
+			return c.Value
+		}
+		if v := h.Get(n); v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// Explain every reason edge would reject token for path, empty if token is accepted
+func (s *AKv2Signer) Explain(token string, path string, req *VerifyRequest) []error {
+	if req == nil {
+		req = &VerifyRequest{}
+	}
+	if token == "" {
+		return []error{fmt.Errorf("%w: token %v is missing", ErrSignatureInvalid, s.TokenNames())}
+	}
+	res := []error{}
+	parts := strings.Split(token, s.fieldDelimiter())
+	fields := map[string]string{}
+	signed := []string{}
+	mac := ""
+	for _, p := range parts {
+		kv := strings.SplitN(p, "=", 2)
+		if len(kv) != 2 {
+			res = append(res, fmt.Errorf("%w: malformed field %q", ErrSignatureInvalid, p))
+			continue
+		}
+		if kv[0] == "hmac" {
+			mac = kv[1]
+			break
+		}
+		fields[kv[0]] = kv[1]
+		signed = append(signed, p)
+	}
+	if mac == "" {
+		res = append(res, fmt.Errorf("%w: hmac is missing", ErrSignatureInvalid))
+	} else if subtle.ConstantTimeCompare([]byte(strings.ToLower(mac)), []byte(s.hmac(signed, path))) != 1 {
+		if boolDefault(s.Policy.MatchURL, true) {
+			res = append(res, fmt.Errorf("%w: hmac doesn't match fields and URL %s, check passPhrase, salt and hashStrategy", ErrSignatureInvalid, path))
+		} else {
+			res = append(res, fmt.Errorf("%w: hmac doesn't match fields, check passPhrase, salt and hashStrategy", ErrSignatureInvalid))
+		}
+	}
+	now := req.now().Unix()
+	if v, ok := fields["exp"]; !ok {
+		res = append(res, fmt.Errorf("%w: exp is missing", ErrSignatureInvalid))
+	} else if exp, e := strconv.ParseInt(v, 10, 64); e != nil {
+		res = append(res, fmt.Errorf("%w: invalid exp %q", ErrSignatureInvalid, v))
+	} else if now > exp {
+		res = append(res, fmt.Errorf("%w: at %s", ErrSignatureExpired, time.Unix(exp, 0).UTC().Format(time.RFC3339)))
+	}
+	if v, ok := fields["st"]; ok {
+		if st, e := strconv.ParseInt(v, 10, 64); e != nil {
+			res = append(res, fmt.Errorf("%w: invalid st %q", ErrSignatureInvalid, v))
+		} else if now < st {
+			res = append(res, fmt.Errorf("%w: until %s", ErrSignatureNotStarted, time.Unix(st, 0).UTC().Format(time.RFC3339)))
+		}
+	}
+	if v, ok := fields["ip"]; ok && v != req.IP {
+		res = append(res, fmt.Errorf("%w: token is bound to IP %s, client is %s", ErrSignatureClient, v, req.IP))
+	}
+	if !boolDefault(s.Policy.MatchURL, true) {
+		if v, ok := fields["acl"]; !ok {
+			res = append(res, fmt.Errorf("%w: acl is missing", ErrSignatureInvalid))
+		} else if !s.aclMatch(v, path) {
+			res = append(res, fmt.Errorf("%w: path %s isn't covered by acl %s", ErrSignatureInvalid, path, v))
+		}
+	}
+	return res
+}
+
+// aclMatch path matches any ACL entry, * is wildcard if enableACLWildcard
+func (s *AKv2Signer) aclMatch(acl string, path string) bool {
+	for _, a := range strings.Split(acl, s.aclDelimiter()) {
+		if a == path {
+			return true
+		}
+		if boolDefault(s.Policy.EnableACLWildcard, true) && strings.Contains(a, "*") {
+			if re, e := regexp.Compile(wildcardToRegexp(a, true)); e == nil && re.MatchString(path) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Verify check token of URL, looked up in query string, then cookie and header of req
+func (s *AKv2Signer) Verify(rawURL string, req *VerifyRequest) error {
+	if req == nil {
+		req = &VerifyRequest{}
+	}
+	u, e := url.Parse(rawURL)
+	if e != nil {
+		return e
+	}
+	if errs := s.Explain(s.findToken(u, req.Header), u.EscapedPath(), req); len(errs) > 0 {
+		return errs[0]
+	}
+	return nil
+}
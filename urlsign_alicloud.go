@@ -0,0 +1,292 @@
+package hwapi
+
+import (
+	"crypto/md5"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// aliCloudZone Type B timestamps are in UTC+8
+var aliCloudZone = time.FixedZone("UTC+8", 8*3600)
+
+// aliCloudTypeBLayout YYYYMMDDHHMM
+const aliCloudTypeBLayout = "200601021504"
+
+func md5Hex(s string) string {
+	sum := md5.Sum([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
+
+func hashEqual(got string, want string) bool {
+	return subtle.ConstantTimeCompare([]byte(strings.ToLower(got)), []byte(want)) == 1
+}
+
+// checkValidity URL with timestamp ts is valid for validity seconds
+func checkValidity(ts time.Time, validity time.Duration, req *VerifyRequest) error {
+	if exp := ts.Add(validity); req.now().After(exp) {
+		return fmt.Errorf("%w: at %s", ErrSignatureExpired, exp.UTC().Format(time.RFC3339))
+	}
+	return nil
+}
+
+// AliCloudASigner sign URLs validated by authUrlSignAliCloudA
+//
+// URL is path?tokenField=timestamp-rand-uid-hash, hash is MD5 hex of "path-timestamp-rand-uid-passPhrase",
+// path includes ?query before token with includeParamsBeforeToken. Timestamp is expire time,
+// edge accepts URL until timestamp + expirationExtension.
+type AliCloudASigner struct {
+	Policy *AuthURLSignAliCloudA
+	// Rand random string without "-", default "0"
+	Rand string
+	// UID user ID, default "0"
+	UID string
+}
+
+// NewAliCloudASigner create signer of authUrlSignAliCloudA policy, passPhrase is required
+func NewAliCloudASigner(p *AuthURLSignAliCloudA) (*AliCloudASigner, error) {
+	switch {
+	case p == nil:
+		return nil, fmt.Errorf("%w: authUrlSignAliCloudA is nil", ErrSignerPolicy)
+	case p.PassPhrase == "":
+		return nil, fmt.Errorf("%w: authUrlSignAliCloudA passPhrase is required", ErrSignerPolicy)
+	}
+	return &AliCloudASigner{Policy: p}, nil
+}
+
+func (s *AliCloudASigner) tokenField() string {
+	return stringDefault(s.Policy.TokenField, "auth_key")
+}
+
+// signedPath path with params before token if includeParamsBeforeToken
+func (s *AliCloudASigner) signedPath(u *url.URL, before []*queryParam) string {
+	path := u.EscapedPath()
+	if boolValue(s.Policy.IncludeParamsBeforeToken) && len(before) > 0 {
+		path += "?" + joinRawQuery(before)
+	}
+	return path
+}
+
+// Sign add token to end of URL, Expires is required
+func (s *AliCloudASigner) Sign(rawURL string, opt *SignOptions) (string, error) {
+	if opt == nil || opt.Expires.IsZero() {
+		return "", fmt.Errorf("%w: expire time is required", ErrSignerPolicy)
+	}
+	rand, uid := stringDefault(s.Rand, "0"), stringDefault(s.UID, "0")
+	if strings.Contains(rand, "-") || strings.Contains(uid, "-") {
+		return "", fmt.Errorf("%w: rand and uid must not contain \"-\"", ErrSignerPolicy)
+	}
+	u, e := url.Parse(rawURL)
+	if e != nil {
+		return "", e
+	}
+	params := withoutParams(parseRawQuery(u.RawQuery), s.tokenField())
+	ts := strconv.FormatInt(opt.Expires.Unix(), 10)
+	hash := md5Hex(strings.Join([]string{s.signedPath(u, params), ts, rand, uid, s.Policy.PassPhrase}, "-"))
+	u.RawQuery = joinRawQuery(append(params, newQueryParam(s.tokenField(), strings.Join([]string{ts, rand, uid, hash}, "-"))))
+	return u.String(), nil
+}
+
+// Verify check URL the way edge does
+func (s *AliCloudASigner) Verify(rawURL string, req *VerifyRequest) error {
+	u, e := url.Parse(rawURL)
+	if e != nil {
+		return e
+	}
+	params := parseRawQuery(u.RawQuery)
+	i, t := findParam(params, s.tokenField())
+	if t == nil {
+		return fmt.Errorf("%w: %s is missing", ErrSignatureInvalid, s.tokenField())
+	}
+	parts := strings.Split(t.value, "-")
+	if len(parts) != 4 {
+		return fmt.Errorf("%w: %s should be timestamp-rand-uid-hash", ErrSignatureInvalid, s.tokenField())
+	}
+	want := md5Hex(strings.Join([]string{s.signedPath(u, params[:i]), parts[0], parts[1], parts[2], s.Policy.PassPhrase}, "-"))
+	if !hashEqual(parts[3], want) {
+		return fmt.Errorf("%w: %s doesn't match", ErrSignatureInvalid, s.tokenField())
+	}
+	ts, e := strconv.ParseInt(parts[0], 10, 64)
+	if e != nil {
+		return fmt.Errorf("%w: invalid timestamp %q", ErrSignatureInvalid, parts[0])
+	}
+	return checkValidity(time.Unix(ts, 0), time.Duration(s.Policy.ExpirationExtension)*time.Second, req)
+}
+
+// splitSignedPath cut leading n segments of path
+func splitSignedPath(path string, n int) ([]string, string, bool) {
+	parts := strings.SplitN(strings.TrimPrefix(path, "/"), "/", n+1)
+	if len(parts) != n+1 || parts[n] == "" {
+		return nil, "", false
+	}
+	return parts[:n], "/" + parts[n], true
+}
+
+// AliCloudBSigner sign URLs validated by authUrlSignAliCloudB
+//
+// URL is /timestamp/hash/path, timestamp is sign time YYYYMMDDHHMM in UTC+8, hash is
+// MD5 hex of passPhrase + timestamp + path. Edge accepts URL until timestamp + expirationExtension, 1800s if omitted from decoded policy.
+type AliCloudBSigner struct {
+	Policy *AuthURLSignAliCloudB
+}
+
+// NewAliCloudBSigner create signer of authUrlSignAliCloudB policy, passPhrase is required
+func NewAliCloudBSigner(p *AuthURLSignAliCloudB) (*AliCloudBSigner, error) {
+	switch {
+	case p == nil:
+		return nil, fmt.Errorf("%w: authUrlSignAliCloudB is nil", ErrSignerPolicy)
+	case p.PassPhrase == "":
+		return nil, fmt.Errorf("%w: authUrlSignAliCloudB passPhrase is required", ErrSignerPolicy)
+	}
+	return &AliCloudBSigner{Policy: p}, nil
+}
+
+// Sign insert timestamp and hash before path, Start is sign time, default now
+func (s *AliCloudBSigner) Sign(rawURL string, opt *SignOptions) (string, error) {
+	start := time.Now()
+	if opt != nil && !opt.Start.IsZero() {
+		start = opt.Start
+	}
+	u, e := url.Parse(rawURL)
+	if e != nil {
+		return "", e
+	}
+	path := u.EscapedPath()
+	ts := start.In(aliCloudZone).Format(aliCloudTypeBLayout)
+	u.RawPath = "/" + ts + "/" + md5Hex(s.Policy.PassPhrase+ts+path) + path
+	if u.Path, e = url.PathUnescape(u.RawPath); e != nil {
+		return "", e
+	}
+	return u.String(), nil
+}
+
+// Verify check URL the way edge does
+func (s *AliCloudBSigner) Verify(rawURL string, req *VerifyRequest) error {
+	u, e := url.Parse(rawURL)
+	if e != nil {
+		return e
+	}
+	parts, path, ok := splitSignedPath(u.EscapedPath(), 2)
+	if !ok {
+		return fmt.Errorf("%w: path should be /timestamp/hash/file", ErrSignatureInvalid)
+	}
+	ts, e := time.ParseInLocation(aliCloudTypeBLayout, parts[0], aliCloudZone)
+	if e != nil {
+		return fmt.Errorf("%w: invalid timestamp %q", ErrSignatureInvalid, parts[0])
+	}
+	if !hashEqual(parts[1], md5Hex(s.Policy.PassPhrase+parts[0]+path)) {
+		return fmt.Errorf("%w: hash doesn't match", ErrSignatureInvalid)
+	}
+	return checkValidity(ts, time.Duration(s.Policy.ExpirationExtension)*time.Second, req)
+}
+
+// AliCloudCSigner sign URLs validated by authUrlSignAliCloudC
+//
+// URL is /hash/timestamp/path, timestamp is sign time as upper case hex unix time, hash is
+// MD5 hex of passPhrase + path + timestamp. With tokenField or expireField, hash or timestamp is sent
+// in query string instead of path. Edge accepts URL until timestamp + expirationExtension, 1800s if omitted from decoded policy.
+type AliCloudCSigner struct {
+	Policy *AuthURLSignAliCloudC
+}
+
+// NewAliCloudCSigner create signer of authUrlSignAliCloudC policy, passPhrase is required
+func NewAliCloudCSigner(p *AuthURLSignAliCloudC) (*AliCloudCSigner, error) {
+	switch {
+	case p == nil:
+		return nil, fmt.Errorf("%w: authUrlSignAliCloudC is nil", ErrSignerPolicy)
+	case p.PassPhrase == "":
+		return nil, fmt.Errorf("%w: authUrlSignAliCloudC passPhrase is required", ErrSignerPolicy)
+	}
+	return &AliCloudCSigner{Policy: p}, nil
+}
+
+// Sign add hash and timestamp to path or query string, Start is sign time, default now
+func (s *AliCloudCSigner) Sign(rawURL string, opt *SignOptions) (string, error) {
+	start := time.Now()
+	if opt != nil && !opt.Start.IsZero() {
+		start = opt.Start
+	}
+	u, e := url.Parse(rawURL)
+	if e != nil {
+		return "", e
+	}
+	p := s.Policy
+	path := u.EscapedPath()
+	ts := strings.ToUpper(strconv.FormatInt(start.Unix(), 16))
+	hash := md5Hex(p.PassPhrase + path + ts)
+	prefix := ""
+	params := withoutParams(parseRawQuery(u.RawQuery), p.TokenField, p.ExpireField)
+	if p.TokenField != "" {
+		params = append(params, newQueryParam(p.TokenField, hash))
+	} else {
+		prefix += "/" + hash
+	}
+	if p.ExpireField != "" {
+		params = append(params, newQueryParam(p.ExpireField, ts))
+	} else {
+		prefix += "/" + ts
+	}
+	u.RawPath = prefix + path
+	if u.Path, e = url.PathUnescape(u.RawPath); e != nil {
+		return "", e
+	}
+	u.RawQuery = joinRawQuery(params)
+	return u.String(), nil
+}
+
+// Verify check URL the way edge does
+func (s *AliCloudCSigner) Verify(rawURL string, req *VerifyRequest) error {
+	u, e := url.Parse(rawURL)
+	if e != nil {
+		return e
+	}
+	p := s.Policy
+	params := parseRawQuery(u.RawQuery)
+	path := u.EscapedPath()
+	hash, ts := "", ""
+	if p.TokenField != "" {
+		if _, t := findParam(params, p.TokenField); t != nil {
+			hash = t.value
+		}
+	}
+	if p.ExpireField != "" {
+		if _, t := findParam(params, p.ExpireField); t != nil {
+			ts = t.value
+		}
+	}
+	n := 0
+	if p.TokenField == "" {
+		n++
+	}
+	if p.ExpireField == "" {
+		n++
+	}
+	if n > 0 {
+		parts, rest, ok := splitSignedPath(path, n)
+		if !ok {
+			return fmt.Errorf("%w: path should start with hash and timestamp", ErrSignatureInvalid)
+		}
+		if p.TokenField == "" {
+			hash, parts = parts[0], parts[1:]
+		}
+		if p.ExpireField == "" {
+			ts = parts[0]
+		}
+		path = rest
+	}
+	if hash == "" || ts == "" {
+		return fmt.Errorf("%w: hash or timestamp is missing", ErrSignatureInvalid)
+	}
+	if !hashEqual(hash, md5Hex(p.PassPhrase+path+ts)) {
+		return fmt.Errorf("%w: hash doesn't match", ErrSignatureInvalid)
+	}
+	sec, e := strconv.ParseInt(ts, 16, 64)
+	if e != nil {
+		return fmt.Errorf("%w: invalid timestamp %q", ErrSignatureInvalid, ts)
+	}
+	return checkValidity(time.Unix(sec, 0), time.Duration(p.ExpirationExtension)*time.Second, req)
+}
@@ -0,0 +1,239 @@
+package hwapi
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Time formats of AuthURLSignL3
+const (
+	L3TimeEpoch    = "epoch"
+	L3TimeDatetime = "datetime"
+)
+
+// l3DatetimeLayout yyyymmddHHMMSS in GMT
+const l3DatetimeLayout = "20060102150405"
+
+// l3DigestLength hex characters of HMAC-SHA1 kept in token
+const l3DigestLength = 20
+
+// L3Signer sign URLs validated by authUrlSignL3
+//
+// Token is index of secret in sharedSecretTable followed by first 20 hex characters of
+// HMAC-SHA1(secret, signed URL). Signed URL is path?query without tokenField and excluded parameters,
+// prefixed by protocol://host with includeProtocolAndHost or host with includeHostOnly.
+// With injectClientIPAddress, clientIPAddressField=IP is appended to query before hashing and isn't sent.
+type L3Signer struct {
+	Policy *AuthURLSignL3
+	// Key index of secret in sharedSecretTable used by Sign, rotate by moving to a newer secret
+	Key int
+
+	secrets  []string
+	excluded []*regexp.Regexp
+	kept     []*regexp.Regexp
+}
+
+// NewL3Signer create signer of authUrlSignL3 policy, sharedSecretTable and tokenField are required
+// Up to 10 secrets are supported since index is a single digit, Key defaults to 0
+func NewL3Signer(p *AuthURLSignL3) (*L3Signer, error) {
+	if p == nil {
+		return nil, fmt.Errorf("%w: authUrlSignL3 is nil", ErrSignerPolicy)
+	}
+	s := &L3Signer{Policy: p, secrets: []string{}, excluded: []*regexp.Regexp{}, kept: []*regexp.Regexp{}}
+	for _, secret := range strings.Split(p.SharedSecretTable, ",") {
+		if secret = strings.TrimSpace(secret); secret != "" {
+			s.secrets = append(s.secrets, secret)
+		}
+	}
+	switch {
+	case len(s.secrets) == 0:
+		return nil, fmt.Errorf("%w: authUrlSignL3 sharedSecretTable is required", ErrSignerPolicy)
+	case len(s.secrets) > 10:
+		return nil, fmt.Errorf("%w: authUrlSignL3 sharedSecretTable has more than 10 secrets", ErrSignerPolicy)
+	case p.TokenField == "":
+		return nil, fmt.Errorf("%w: authUrlSignL3 tokenField is required", ErrSignerPolicy)
+	case p.TimeFormat != "" && p.TimeFormat != L3TimeEpoch && p.TimeFormat != L3TimeDatetime:
+		return nil, fmt.Errorf("%w: authUrlSignL3 unknown timeFormat %q", ErrSignerPolicy, p.TimeFormat)
+	}
+	for _, pattern := range strings.Split(p.ExcludedParameters, ",") {
+		if pattern = strings.TrimSpace(pattern); pattern == "" {
+			continue
+		}
+		keep := strings.HasPrefix(pattern, "!")
+		re, e := regexp.Compile(wildcardToRegexp(strings.TrimPrefix(pattern, "!"), true))
+		if e != nil {
+			return nil, fmt.Errorf("%w: authUrlSignL3 excludedParameters %q, %s", ErrSignerPolicy, pattern, e.Error())
+		}
+		if keep {
+			s.kept = append(s.kept, re)
+		} else {
+			s.excluded = append(s.excluded, re)
+		}
+	}
+	return s, nil
+}
+
+// isExcluded parameter is omitted from hash, start and expire fields are always hashed
+func (s *L3Signer) isExcluded(name string) bool {
+	if name == s.Policy.TokenField {
+		return true
+	}
+	if name == s.Policy.StartField || name == s.Policy.ExpireField {
+		return false
+	}
+	for _, re := range s.kept {
+		if re.MatchString(name) {
+			return false
+		}
+	}
+	for _, re := range s.excluded {
+		if re.MatchString(name) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *L3Signer) formatTime(t time.Time) string {
+	if s.Policy.TimeFormat == L3TimeDatetime {
+		return t.UTC().Format(l3DatetimeLayout)
+	}
+	return strconv.FormatInt(t.Unix(), 10)
+}
+
+func (s *L3Signer) parseTime(v string) (time.Time, error) {
+	if s.Policy.TimeFormat == L3TimeDatetime {
+		return time.Parse(l3DatetimeLayout, v)
+	}
+	n, e := strconv.ParseInt(v, 10, 64)
+	if e != nil {
+		return time.Time{}, e
+	}
+	return time.Unix(n, 0), nil
+}
+
+// digest HMAC-SHA1 of signed URL with secret at index
+func (s *L3Signer) digest(u *url.URL, params []*queryParam, key int, ip string) string {
+	p := s.Policy
+	signed := []*queryParam{}
+	for _, q := range params {
+		if !s.isExcluded(q.name) {
+			signed = append(signed, q)
+		}
+	}
+	if boolValue(p.InjectClientIPAddress) {
+		name := p.ClientIPAddressField
+		if name == "" {
+			name = "clientip"
+		}
+		signed = append(signed, newQueryParam(name, ip))
+	}
+	b := &strings.Builder{}
+	switch {
+	case boolValue(p.IncludeProtocolAndHost):
+		b.WriteString(u.Scheme + "://" + u.Host)
+	case boolValue(p.IncludeHostOnly):
+		b.WriteString(u.Host)
+	}
+	path := u.EscapedPath()
+	if path == "" {
+		path = "/"
+	}
+	b.WriteString(path)
+	if q := joinRawQuery(signed); q != "" {
+		b.WriteString("?" + q)
+	}
+	m := hmac.New(sha1.New, []byte(s.secrets[key]))
+	m.Write([]byte(b.String()))
+	return hex.EncodeToString(m.Sum(nil))[:l3DigestLength]
+}
+
+// Sign add start, expire and token to URL, IP is required with injectClientIPAddress
+func (s *L3Signer) Sign(rawURL string, opt *SignOptions) (string, error) {
+	if opt == nil {
+		opt = &SignOptions{}
+	}
+	if s.Key < 0 || s.Key >= len(s.secrets) {
+		return "", fmt.Errorf("%w: key %d out of sharedSecretTable", ErrSignerPolicy, s.Key)
+	}
+	p := s.Policy
+	if boolValue(p.InjectClientIPAddress) && opt.IP == "" {
+		return "", fmt.Errorf("%w: client IP is required by injectClientIPAddress", ErrSignerPolicy)
+	}
+	if p.ExpireField != "" && opt.Expires.IsZero() {
+		return "", fmt.Errorf("%w: expire time is required by expireField", ErrSignerPolicy)
+	}
+	u, e := url.Parse(rawURL)
+	if e != nil {
+		return "", e
+	}
+	params := withoutParams(parseRawQuery(u.RawQuery), p.TokenField, p.StartField, p.ExpireField)
+	if p.StartField != "" && !opt.Start.IsZero() {
+		params = append(params, newQueryParam(p.StartField, s.formatTime(opt.Start)))
+	}
+	if p.ExpireField != "" {
+		params = append(params, newQueryParam(p.ExpireField, s.formatTime(opt.Expires)))
+	}
+	token := strconv.Itoa(s.Key) + s.digest(u, params, s.Key, opt.IP)
+	u.RawQuery = joinRawQuery(append(params, newQueryParam(p.TokenField, token)))
+	return u.String(), nil
+}
+
+// Verify check URL the way edge does, any secret of sharedSecretTable is accepted by its index
+func (s *L3Signer) Verify(rawURL string, req *VerifyRequest) error {
+	if req == nil {
+		req = &VerifyRequest{}
+	}
+	u, e := url.Parse(rawURL)
+	if e != nil {
+		return e
+	}
+	p := s.Policy
+	params := parseRawQuery(u.RawQuery)
+	_, t := findParam(params, p.TokenField)
+	if t == nil || len(t.value) != l3DigestLength+1 {
+		return fmt.Errorf("%w: %s is missing or malformed", ErrSignatureInvalid, p.TokenField)
+	}
+	key, e := strconv.Atoi(t.value[:1])
+	if e != nil || key >= len(s.secrets) {
+		return fmt.Errorf("%w: unknown secret index %q", ErrSignatureInvalid, t.value[:1])
+	}
+	if subtle.ConstantTimeCompare([]byte(strings.ToLower(t.value[1:])), []byte(s.digest(u, params, key, req.IP))) != 1 {
+		if boolValue(p.InjectClientIPAddress) {
+			return fmt.Errorf("%w: %s doesn't match URL or client IP %s", ErrSignatureInvalid, p.TokenField, req.IP)
+		}
+		return fmt.Errorf("%w: %s doesn't match", ErrSignatureInvalid, p.TokenField)
+	}
+	now := req.now()
+	if _, st := findParam(params, p.StartField); st != nil {
+		start, e := s.parseTime(st.value)
+		if e != nil {
+			return fmt.Errorf("%w: invalid %s %q", ErrSignatureInvalid, p.StartField, st.value)
+		}
+		if now.Before(start) {
+			return fmt.Errorf("%w: until %s", ErrSignatureNotStarted, start.UTC().Format(time.RFC3339))
+		}
+	}
+	if p.ExpireField != "" {
+		_, ex := findParam(params, p.ExpireField)
+		if ex == nil {
+			return fmt.Errorf("%w: %s is missing", ErrSignatureInvalid, p.ExpireField)
+		}
+		exp, e := s.parseTime(ex.value)
+		if e != nil {
+			return fmt.Errorf("%w: invalid %s %q", ErrSignatureInvalid, p.ExpireField, ex.value)
+		}
+		if now.After(exp) {
+			return fmt.Errorf("%w: at %s", ErrSignatureExpired, exp.UTC().Format(time.RFC3339))
+		}
+	}
+	return nil
+}
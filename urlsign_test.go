@@ -0,0 +1,359 @@
+package hwapi_test
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bucloud/hwapi"
+)
+
+func TestAuthURLSigner(t *testing.T) {
+	if _, e := hwapi.NewAuthURLSigner(&hwapi.AuthURLSign{TokenField: "token"}); !errors.Is(e, hwapi.ErrSignerPolicy) {
+		t.Errorf("expect ErrSignerPolicy, got %v", e)
+	}
+	s, e := hwapi.NewAuthURLSigner(&hwapi.AuthURLSign{TokenField: "token", PassPhraseField: "secret", PassPhrase: "mypassphrase", ExpiresField: "expires", IPAddressField: "ip", UserAgentField: "ua", URILengthField: "len"})
+	if e != nil {
+		t.Fatal(e)
+	}
+	var signer hwapi.Signer = s
+	exp := time.Unix(1234567890, 0)
+
+	// MD5("/foo/bar.zip?expires=1234567890&secret=mypassphrase")
+	u, e := signer.Sign("http://cdn.example.com/foo/bar.zip?token=old", &hwapi.SignOptions{Expires: exp})
+	if want := "http://cdn.example.com/foo/bar.zip?expires=1234567890&token=5bdc249e6de6edcfbdb3454c62bf0498"; e != nil || u != want {
+		t.Errorf("got %s %v, want %s", u, e, want)
+	}
+	if e := signer.Verify(u, &hwapi.VerifyRequest{Now: exp}); e != nil {
+		t.Error(e)
+	}
+	if e := signer.Verify(u, &hwapi.VerifyRequest{Now: exp.Add(time.Second)}); !errors.Is(e, hwapi.ErrSignatureExpired) {
+		t.Errorf("expect expired, got %v", e)
+	}
+	if e := signer.Verify(u[:len(u)-1]+"1", nil); !errors.Is(e, hwapi.ErrSignatureInvalid) {
+		t.Errorf("expect invalid, got %v", e)
+	}
+
+	// MD5("foo/?secret=mypassphrase"), directory only
+	zero := 0
+	u, _ = signer.Sign("http://cdn.example.com/foo/bar.zip", &hwapi.SignOptions{URILength: &zero})
+	if want := "http://cdn.example.com/foo/bar.zip?len=0&token="; u[:len(want)] != want {
+		t.Errorf("got %s", u)
+	}
+	if e := signer.Verify("http://cdn.example.com/foo/other.zip"+u[len("http://cdn.example.com/foo/bar.zip"):], nil); e != nil {
+		t.Errorf("directory signature should cover other file, %v", e)
+	}
+
+	// uriLengthField example of authUrlSign documentation, first 10 bytes of path and the query string,
+	// MD5("this/is/my?queryStringStuff&len=10&secret=mypassphrase")
+	ten := 10
+	u, _ = signer.Sign("http://mydomain.com/this/is/my/path/to/a/file?queryStringStuff", &hwapi.SignOptions{URILength: &ten})
+	if want := "http://mydomain.com/this/is/my/path/to/a/file?queryStringStuff&len=10&token=49bc8f26d0038fd6cbd80f2cf3d8b7fc"; u != want {
+		t.Errorf("got %s, want %s", u, want)
+	}
+	if e := signer.Verify("http://mydomain.com/this/is/my/other/file"+u[len("http://mydomain.com/this/is/my/path/to/a/file"):], nil); e != nil {
+		t.Errorf("bytes after uriLength shouldn't been signed, %v", e)
+	}
+
+	// MD5("/a?ua=curl%2F8&secret=mypassphrase"), user agent taken from request
+	u, _ = signer.Sign("http://cdn.example.com/a", &hwapi.SignOptions{UserAgent: "curl/8", IP: ""})
+	if want := "http://cdn.example.com/a?ua=&token=cf6a667e503aefef95202138e379cc4a"; u != want {
+		t.Errorf("got %s, want %s", u, want)
+	}
+	if e := signer.Verify(u, &hwapi.VerifyRequest{UserAgent: "curl/7"}); !errors.Is(e, hwapi.ErrSignatureInvalid) {
+		t.Errorf("expect invalid for other user agent, got %v", e)
+	}
+
+	u, _ = signer.Sign("http://cdn.example.com/a?x=1", &hwapi.SignOptions{IP: "10.0.0.1"})
+	if e := signer.Verify(u, &hwapi.VerifyRequest{IP: "10.0.0.1"}); e != nil {
+		t.Error(e)
+	}
+	if e := signer.Verify(u, &hwapi.VerifyRequest{IP: "10.0.0.2"}); !errors.Is(e, hwapi.ErrSignatureClient) {
+		t.Errorf("expect client error, got %v", e)
+	}
+	if e := signer.Verify(u+"&after=1", &hwapi.VerifyRequest{IP: "10.0.0.1"}); !errors.Is(e, hwapi.ErrSignatureInvalid) {
+		t.Errorf("fields after token should been signed, got %v", e)
+	}
+	s.Policy.IgnoreFieldsAfterToken = new(bool)
+	*s.Policy.IgnoreFieldsAfterToken = true
+	if e := signer.Verify(u+"&after=1", &hwapi.VerifyRequest{IP: "10.0.0.1"}); e != nil {
+		t.Error(e)
+	}
+}
+
+func TestL3Signer(t *testing.T) {
+	p := &hwapi.AuthURLSignL3{SharedSecretTable: "secret1, secret2", TokenField: "encoded", TimeFormat: "datetime", StartField: "stime", ExpireField: "etime", ExcludedParameters: "*,!v"}
+	s, e := hwapi.NewL3Signer(p)
+	if e != nil {
+		t.Fatal(e)
+	}
+	exp := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// HMAC-SHA1("secret1", "/a/b.mp4?v=2&etime=20200101000000"), x is excluded
+	u, e := s.Sign("http://cdn.example.com/a/b.mp4?v=2&x=1", &hwapi.SignOptions{Expires: exp})
+	if want := "http://cdn.example.com/a/b.mp4?v=2&x=1&etime=20200101000000&encoded=0e59451e35ea244f20e4e"; e != nil || u != want {
+		t.Errorf("got %s %v, want %s", u, e, want)
+	}
+	if e := s.Verify(strings.Replace(u, "x=1", "x=2", 1), &hwapi.VerifyRequest{Now: exp}); e != nil {
+		t.Errorf("excluded parameter changed, %v", e)
+	}
+	if e := s.Verify(strings.Replace(u, "v=2", "v=3", 1), &hwapi.VerifyRequest{Now: exp}); !errors.Is(e, hwapi.ErrSignatureInvalid) {
+		t.Errorf("expect invalid, got %v", e)
+	}
+	if e := s.Verify(u, &hwapi.VerifyRequest{Now: exp.Add(time.Second)}); !errors.Is(e, hwapi.ErrSignatureExpired) {
+		t.Errorf("expect expired, got %v", e)
+	}
+	u, _ = s.Sign("http://cdn.example.com/a", &hwapi.SignOptions{Start: exp, Expires: exp.Add(time.Hour)})
+	if e := s.Verify(u, &hwapi.VerifyRequest{Now: exp.Add(-time.Second)}); !errors.Is(e, hwapi.ErrSignatureNotStarted) {
+		t.Errorf("expect not started, got %v", e)
+	}
+
+	// HMAC-SHA1("secret2", "http://cdn.example.com/a?etime=20200101000000&clientip=10.0.0.1")
+	p.TimeFormat, p.StartField, p.ExcludedParameters = "datetime", "", ""
+	p.IncludeProtocolAndHost, p.InjectClientIPAddress = new(bool), new(bool)
+	*p.IncludeProtocolAndHost, *p.InjectClientIPAddress = true, true
+	s, _ = hwapi.NewL3Signer(p)
+	s.Key = 1
+	u, e = s.Sign("http://cdn.example.com/a", &hwapi.SignOptions{Expires: exp, IP: "10.0.0.1"})
+	if want := "http://cdn.example.com/a?etime=20200101000000&encoded=1b29b54b4515e9481df2f"; e != nil || u != want {
+		t.Errorf("got %s %v, want %s", u, e, want)
+	}
+	if e := s.Verify(u, &hwapi.VerifyRequest{Now: exp, IP: "10.0.0.2"}); !errors.Is(e, hwapi.ErrSignatureInvalid) {
+		t.Errorf("expect invalid for other IP, got %v", e)
+	}
+	// URLs signed by rotated key still verify
+	s.Key = 0
+	if e := s.Verify(u, &hwapi.VerifyRequest{Now: exp, IP: "10.0.0.1"}); e != nil {
+		t.Error(e)
+	}
+}
+
+func TestAKv1Signer(t *testing.T) {
+	s, e := hwapi.NewAKv1Signer(&hwapi.AuthURLSignAKv1{Salt: "salt", Extract: "header:User-Agent"})
+	if e != nil {
+		t.Fatal(e)
+	}
+	exp := time.Unix(1577836800, 0)
+	// urlauth_gen_token of Akamai reference code, MD5("salt" + raw MD5("\x00\xe1\x0b\x5e/a.mp4__gda__curl/8salt")),
+	// expires 1577836800 is hashed as 4 bytes little endian
+	u, e := s.Sign("http://cdn.example.com/a.mp4", &hwapi.SignOptions{Expires: exp, UserAgent: "curl/8"})
+	if want := "http://cdn.example.com/a.mp4?__gda__=1577836800_01c65908d96a07e93fcd84aadf1f1dab"; e != nil || u != want {
+		t.Errorf("got %s %v, want %s", u, e, want)
+	}
+	if e := s.Verify(u, &hwapi.VerifyRequest{Now: exp, Header: http.Header{"User-Agent": {"curl/8"}}}); e != nil {
+		t.Error(e)
+	}
+	if e := s.Verify(u, &hwapi.VerifyRequest{Now: exp}); !errors.Is(e, hwapi.ErrSignatureClient) {
+		t.Errorf("expect missing header, got %v", e)
+	}
+	if e := s.Verify(u, &hwapi.VerifyRequest{Now: exp.Add(time.Second), UserAgent: "curl/8"}); !errors.Is(e, hwapi.ErrSignatureExpired) {
+		t.Errorf("expect expired, got %v", e)
+	}
+	if e := s.Verify(strings.Replace(u, "1577836800_", "1577836801_", 1), &hwapi.VerifyRequest{Now: exp, UserAgent: "curl/8"}); !errors.Is(e, hwapi.ErrSignatureInvalid) {
+		t.Errorf("expect expires covered by hash, got %v", e)
+	}
+}
+
+func TestAKv2Signer(t *testing.T) {
+	p := &hwapi.AuthURLSignAKv2{PassPhrase: "abcdef0123456789", MatchURL: new(bool), TokenField: "hdnts,hdntl"}
+	s, e := hwapi.NewAKv2Signer(p)
+	if e != nil {
+		t.Fatal(e)
+	}
+	exp := time.Unix(1577836800, 0)
+	// HMAC-SHA256(abcdef0123456789, "exp=1577836800~acl=/a/*!/b/*")
+	tok := &hwapi.AKToken{ACL: []string{"/a/*", "/b/*"}, Expires: exp}
+	c, e := s.Cookie(tok)
+	if want := "exp=1577836800~acl=/a/*!/b/*~hmac=06f40ae6fb3ac968eeba33c6b632a4b8f50d511002b35830c4066a5bf2d1490f"; e != nil || c.Name != "hdnts" || c.Value != want {
+		t.Errorf("got %v %v, want %s", c, e, want)
+	}
+	if e := s.Verify("http://cdn.example.com/b/c/d.ts", &hwapi.VerifyRequest{Now: exp, Header: http.Header{"Cookie": {c.String()}}}); e != nil {
+		t.Error(e)
+	}
+	h, _ := s.Header(tok)
+	if e := s.Verify("http://cdn.example.com/c.ts", &hwapi.VerifyRequest{Now: exp, Header: h}); !errors.Is(e, hwapi.ErrSignatureInvalid) {
+		t.Errorf("expect path outside acl, got %v", e)
+	}
+	if errs := s.Explain(c.Value, "/c.ts", &hwapi.VerifyRequest{Now: exp.Add(time.Second)}); len(errs) != 2 || !errors.Is(errs[0], hwapi.ErrSignatureExpired) {
+		t.Errorf("expect expired and acl, got %v", errs)
+	}
+
+	// HMAC-SHA1(abcdef0123456789, "ip=10.0.0.1~exp=1577836800~url=/v/a.mp4~salt=pepper")
+	p.MatchURL, p.HashStrategy, p.Salt = nil, "sha1", "pepper"
+	s, _ = hwapi.NewAKv2Signer(p)
+	u, e := s.Sign("http://cdn.example.com/v/a.mp4", &hwapi.SignOptions{IP: "10.0.0.1", Expires: exp})
+	if want := "http://cdn.example.com/v/a.mp4?hdnts=ip%3D10.0.0.1~exp%3D1577836800~hmac%3Dc0c769388e28fbb27350f4a95457502db2e93d33"; e != nil || u != want {
+		t.Errorf("got %s %v, want %s", u, e, want)
+	}
+	if e := s.Verify(u, &hwapi.VerifyRequest{Now: exp, IP: "10.0.0.1"}); e != nil {
+		t.Error(e)
+	}
+	if e := s.Verify(strings.Replace(u, "a.mp4", "b.mp4", 1), &hwapi.VerifyRequest{Now: exp, IP: "10.0.0.1"}); !errors.Is(e, hwapi.ErrSignatureInvalid) {
+		t.Errorf("expect invalid for other URL, got %v", e)
+	}
+	if e := s.Verify(u, &hwapi.VerifyRequest{Now: exp, IP: "10.0.0.2"}); !errors.Is(e, hwapi.ErrSignatureClient) {
+		t.Errorf("expect client error, got %v", e)
+	}
+	if _, e := hwapi.NewAKv2Signer(&hwapi.AuthURLSignAKv2{PassPhrase: "xyz"}); !errors.Is(e, hwapi.ErrSignerPolicy) {
+		t.Errorf("expect ErrSignerPolicy, got %v", e)
+	}
+}
+
+func TestAliCloudSigners(t *testing.T) {
+	const key = "aliyuncdnexp1234"
+
+	// Type A example of Alibaba Cloud documentation, MD5("/video/standard/1K.html-1444435200-0-0-aliyuncdnexp1234")
+	a, e := hwapi.NewAliCloudASigner(&hwapi.AuthURLSignAliCloudA{PassPhrase: key, ExpirationExtension: 60})
+	if e != nil {
+		t.Fatal(e)
+	}
+	exp := time.Unix(1444435200, 0)
+	u, e := a.Sign("http://cdn.example.com/video/standard/1K.html", &hwapi.SignOptions{Expires: exp})
+	if want := "http://cdn.example.com/video/standard/1K.html?auth_key=1444435200-0-0-80cd3862d699b7118eed99103f2a3a4f"; e != nil || u != want {
+		t.Errorf("got %s %v, want %s", u, e, want)
+	}
+	if e := a.Verify(u, &hwapi.VerifyRequest{Now: exp.Add(time.Minute)}); e != nil {
+		t.Error(e)
+	}
+	if e := a.Verify(u, &hwapi.VerifyRequest{Now: exp.Add(time.Minute + time.Second)}); !errors.Is(e, hwapi.ErrSignatureExpired) {
+		t.Errorf("expect expired, got %v", e)
+	}
+	a.Policy.IncludeParamsBeforeToken = new(bool)
+	*a.Policy.IncludeParamsBeforeToken = true
+	u, _ = a.Sign("http://cdn.example.com/a.mp4?x=1", &hwapi.SignOptions{Expires: exp})
+	if e := a.Verify(strings.Replace(u, "x=1", "x=2", 1), &hwapi.VerifyRequest{Now: exp}); !errors.Is(e, hwapi.ErrSignatureInvalid) {
+		t.Errorf("params before token should been signed, got %v", e)
+	}
+
+	// Type B example of Alibaba Cloud documentation, MD5("aliyuncdnexp1234201508150800/4/44/44c0909bcfc20a01afaf256ca99a8b8b.mp3")
+	b, _ := hwapi.NewAliCloudBSigner(&hwapi.AuthURLSignAliCloudB{PassPhrase: key, ExpirationExtension: 1800})
+	start := time.Date(2015, 8, 15, 0, 0, 0, 0, time.UTC)
+	u, e = b.Sign("http://cdn.example.com/4/44/44c0909bcfc20a01afaf256ca99a8b8b.mp3", &hwapi.SignOptions{Start: start})
+	if want := "http://cdn.example.com/201508150800/9044548ef1527deadafa49a890a377f0/4/44/44c0909bcfc20a01afaf256ca99a8b8b.mp3"; e != nil || u != want {
+		t.Errorf("got %s %v, want %s", u, e, want)
+	}
+	if e := b.Verify(u, &hwapi.VerifyRequest{Now: start.Add(30 * time.Minute)}); e != nil {
+		t.Error(e)
+	}
+	if e := b.Verify(u, &hwapi.VerifyRequest{Now: start.Add(31 * time.Minute)}); !errors.Is(e, hwapi.ErrSignatureExpired) {
+		t.Errorf("expect expired, got %v", e)
+	}
+	// explicit zero expirationExtension isn't defaulted
+	conf := &hwapi.Configuration{}
+	if e := json.Unmarshal([]byte(`{"authUrlSignAliCloudB":[{"passPhrase":"aliyuncdnexp1234","expirationExtension":0}]}`), conf); e != nil {
+		t.Fatal(e)
+	}
+	b, _ = hwapi.NewAliCloudBSigner(conf.AuthURLSignAliCloudB[0])
+	if e := b.Verify(u, &hwapi.VerifyRequest{Now: start.Add(time.Second)}); !errors.Is(e, hwapi.ErrSignatureExpired) {
+		t.Errorf("expect expired without extension, got %v", e)
+	}
+
+	// Type C example of Alibaba Cloud documentation, MD5("aliyuncdnexp1234/test.flv55CE8100")
+	c, _ := hwapi.NewAliCloudCSigner(&hwapi.AuthURLSignAliCloudC{PassPhrase: key, ExpirationExtension: 1800})
+	u, e = c.Sign("http://cdn.example.com/test.flv", &hwapi.SignOptions{Start: start})
+	if want := "http://cdn.example.com/a37fa50a5fb8f71214b1e7c95ec7a1bd/55CE8100/test.flv"; e != nil || u != want {
+		t.Errorf("got %s %v, want %s", u, e, want)
+	}
+	if e := c.Verify(u, &hwapi.VerifyRequest{Now: start}); e != nil {
+		t.Error(e)
+	}
+	c.Policy.TokenField, c.Policy.ExpireField = "KEY1", "KEY2"
+	u, _ = c.Sign("http://cdn.example.com/test.flv", &hwapi.SignOptions{Start: start})
+	if want := "http://cdn.example.com/test.flv?KEY1=a37fa50a5fb8f71214b1e7c95ec7a1bd&KEY2=55CE8100"; u != want {
+		t.Errorf("got %s, want %s", u, want)
+	}
+	if e := c.Verify(u, &hwapi.VerifyRequest{Now: start}); e != nil {
+		t.Error(e)
+	}
+	if e := c.Verify(strings.Replace(u, ".flv", ".mp4", 1), &hwapi.VerifyRequest{Now: start}); !errors.Is(e, hwapi.ErrSignatureInvalid) {
+		t.Errorf("expect invalid, got %v", e)
+	}
+}
+
+func TestTLUSigner(t *testing.T) {
+	p := &hwapi.AuthURLSignHmacTlu{AlgorithmIDMap: "1:hmacsha1,2:hmacsha256", SymmetricKeyIDMap: `{"k1":"k1secret","k2":"k2secret"}`}
+	s, e := hwapi.NewTLUSigner(p)
+	if e != nil {
+		t.Fatal(e)
+	}
+	if s.KeyID != "k1" || s.AlgorithmID != "1" {
+		t.Errorf("default IDs %s %s", s.KeyID, s.AlgorithmID)
+	}
+	exp := time.Unix(1577836800, 0)
+	// HMAC-SHA256("k2secret", "http://cdn.example.com/a.mp4?x=1&P1=1577836800&P2=k2&P3=2")
+	s.KeyID, s.AlgorithmID = "k2", "2"
+	u, e := s.Sign("http://cdn.example.com/a.mp4?x=1", &hwapi.SignOptions{Expires: exp})
+	if want := "http://cdn.example.com/a.mp4?x=1&P1=1577836800&P2=k2&P3=2&P4=e935fc4b0558efe516e94888d86eaffdfe183b215966a135bcf908d4ba8a3e2c"; e != nil || u != want {
+		t.Errorf("got %s %v, want %s", u, e, want)
+	}
+	s.KeyID, s.AlgorithmID = "k1", "1"
+	old, _ := s.Sign("http://cdn.example.com/a.mp4", &hwapi.SignOptions{Expires: exp})
+	for _, v := range []string{u, old} {
+		if e := s.Verify(v, &hwapi.VerifyRequest{Now: exp}); e != nil {
+			t.Error(e)
+		}
+	}
+	if e := s.Verify(strings.Replace(u, "P2=k2", "P2=k3", 1), &hwapi.VerifyRequest{Now: exp}); !errors.Is(e, hwapi.ErrSignatureInvalid) {
+		t.Errorf("expect unknown key, got %v", e)
+	}
+	if e := s.Verify(u, &hwapi.VerifyRequest{Now: exp.Add(time.Second)}); !errors.Is(e, hwapi.ErrSignatureExpired) {
+		t.Errorf("expect expired, got %v", e)
+	}
+
+	// HMAC-SHA256("a&b", "http://cdn.example.com/a.mp4?P1=1577836800&P2=k3&P3=2"), key is HTML unescaped
+	s, _ = hwapi.NewTLUSigner(&hwapi.AuthURLSignHmacTlu{AlgorithmIDMap: "2:hmacsha256", SymmetricKeyIDMap: "k3:a&amp;b"})
+	u, e = s.Sign("http://cdn.example.com/a.mp4", &hwapi.SignOptions{Expires: exp})
+	if want := "http://cdn.example.com/a.mp4?P1=1577836800&P2=k3&P3=2&P4=808a5b1a455df70e88d8ee98f83a299b9222f37d552e3a48981e43711008b1ce"; e != nil || u != want {
+		t.Errorf("got %s %v, want %s", u, e, want)
+	}
+	if _, e := hwapi.NewTLUSigner(&hwapi.AuthURLSignHmacTlu{AlgorithmIDMap: "1:md4", SymmetricKeyIDMap: "a:b"}); !errors.Is(e, hwapi.ErrSignerPolicy) {
+		t.Errorf("expect ErrSignerPolicy, got %v", e)
+	}
+}
+
+func TestAsymmetricTLUSigner(t *testing.T) {
+	rk, _ := rsa.GenerateKey(rand.Reader, 2048)
+	ek, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	rsaPub, _ := hwapi.FormatTLUPublicKey(&rk.PublicKey)
+	ecPub, _ := hwapi.FormatTLUPublicKey(&ek.PublicKey)
+	p := &hwapi.AuthURLAsymmetricSignTlu{AlgorithmIDMap: "1:rsasha256,2:ecdsasha256", PublicKeyIDMap: "r:" + rsaPub + ",e:" + ecPub}
+	exp := time.Unix(1577836800, 0)
+
+	verifier, e := hwapi.NewAsymmetricTLUSigner(p, nil)
+	if e != nil {
+		t.Fatal(e)
+	}
+	if _, e := verifier.Sign("http://cdn.example.com/a", &hwapi.SignOptions{Expires: exp}); !errors.Is(e, hwapi.ErrSignerPolicy) {
+		t.Errorf("expect private key required, got %v", e)
+	}
+	for _, c := range []struct {
+		key       crypto.Signer
+		id, algID string
+	}{{rk, "r", "1"}, {ek, "e", "2"}} {
+		s, _ := hwapi.NewAsymmetricTLUSigner(p, c.key)
+		s.KeyID, s.AlgorithmID = c.id, c.algID
+		u, e := s.Sign("http://cdn.example.com/a.mp4", &hwapi.SignOptions{Expires: exp})
+		if e != nil {
+			t.Fatal(e)
+		}
+		if e := verifier.Verify(u, &hwapi.VerifyRequest{Now: exp}); e != nil {
+			t.Error(e)
+		}
+		if e := verifier.Verify(strings.Replace(u, "a.mp4", "b.mp4", 1), &hwapi.VerifyRequest{Now: exp}); !errors.Is(e, hwapi.ErrSignatureInvalid) {
+			t.Errorf("expect invalid, got %v", e)
+		}
+	}
+	s, _ := hwapi.NewAsymmetricTLUSigner(p, ek)
+	s.KeyID = "r"
+	if _, e := s.Sign("http://cdn.example.com/a", &hwapi.SignOptions{Expires: exp}); !errors.Is(e, hwapi.ErrSignerPolicy) {
+		t.Errorf("expect key mismatch, got %v", e)
+	}
+}
@@ -0,0 +1,463 @@
+package hwapi
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"html"
+	"math/big"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	// hash functions used by TLU algorithms
+	_ "crypto/sha1"
+	_ "crypto/sha256"
+	_ "crypto/sha512"
+)
+
+// HashMapEntry key-value pair of hashMap field, such like algorithmIdMap
+type HashMapEntry struct {
+	Key   string
+	Value string
+}
+
+// ParseHashMap parse hashMap field, either a JSON object or comma separated id:value pairs, order is kept
+func ParseHashMap(s string) ([]*HashMapEntry, error) {
+	res := []*HashMapEntry{}
+	s = strings.TrimSpace(s)
+	if strings.HasPrefix(s, "{") {
+		d := json.NewDecoder(strings.NewReader(s))
+		if _, e := d.Token(); e != nil {
+			return nil, e
+		}
+		for d.More() {
+			k, e := d.Token()
+			if e != nil {
+				return nil, e
+			}
+			var v string
+			if e := d.Decode(&v); e != nil {
+				return nil, e
+			}
+			res = append(res, &HashMapEntry{Key: fmt.Sprint(k), Value: v})
+		}
+		return res, nil
+	}
+	for _, pair := range strings.Split(s, ",") {
+		if pair = strings.TrimSpace(pair); pair == "" {
+			continue
+		}
+		i := strings.Index(pair, ":")
+		if i <= 0 {
+			return nil, fmt.Errorf("invalid pair %q, should be id:value", pair)
+		}
+		res = append(res, &HashMapEntry{Key: strings.TrimSpace(pair[:i]), Value: strings.TrimSpace(pair[i+1:])})
+	}
+	return res, nil
+}
+
+// tluAlgorithm digest of algorithm name, such like hmacsha256, rsasha256 or ecdsasha256
+func tluAlgorithm(name string) (crypto.Hash, error) {
+	n := strings.ToLower(name)
+	for _, prefix := range []string{"hmac", "rsa", "ecdsa"} {
+		n = strings.TrimPrefix(n, prefix)
+	}
+	switch strings.TrimLeft(n, "-_") {
+	case "sha1":
+		return crypto.SHA1, nil
+	case "sha256":
+		return crypto.SHA256, nil
+	case "sha384":
+		return crypto.SHA384, nil
+	case "sha512":
+		return crypto.SHA512, nil
+	}
+	return 0, fmt.Errorf("unknown algorithm %q", name)
+}
+
+// tluParams query parameter names of TLU policies
+type tluParams struct {
+	expire      string
+	keyID       string
+	algorithmID string
+	digest      string
+}
+
+// tluSigning state shared by symmetric and asymmetric TLU signers
+type tluSigning struct {
+	params     tluParams
+	algorithms map[string]crypto.Hash
+	// firstAlgorithm first ID of algorithmIdMap, default of AlgorithmID
+	firstAlgorithm string
+}
+
+func newTLUSigning(policy string, algorithmIDMap string, p tluParams) (*tluSigning, error) {
+	s := &tluSigning{params: p, algorithms: map[string]crypto.Hash{}}
+	for n, def := range map[*string]string{&s.params.expire: "P1", &s.params.keyID: "P2", &s.params.algorithmID: "P3", &s.params.digest: "P4"} {
+		*n = stringDefault(*n, def)
+	}
+	entries, e := ParseHashMap(algorithmIDMap)
+	if e != nil {
+		return nil, fmt.Errorf("%w: %s algorithmIdMap, %s", ErrSignerPolicy, policy, e.Error())
+	}
+	for _, a := range entries {
+		h, e := tluAlgorithm(a.Value)
+		if e != nil {
+			return nil, fmt.Errorf("%w: %s algorithmIdMap, %s", ErrSignerPolicy, policy, e.Error())
+		}
+		if s.firstAlgorithm == "" {
+			s.firstAlgorithm = a.Key
+		}
+		s.algorithms[a.Key] = h
+	}
+	if len(s.algorithms) == 0 {
+		return nil, fmt.Errorf("%w: %s algorithmIdMap is required", ErrSignerPolicy, policy)
+	}
+	return s, nil
+}
+
+// tluMessage signed part of URL, protocol://host/path?query without digest parameter
+func tluMessage(u *url.URL, params []*queryParam) string {
+	path := u.EscapedPath()
+	if path == "" {
+		path = "/"
+	}
+	return u.Scheme + "://" + u.Host + path + "?" + joinRawQuery(params)
+}
+
+// sign add expire, key ID, algorithm ID and digest parameters, digest is computed by sign
+func (s *tluSigning) sign(rawURL string, opt *SignOptions, keyID string, algorithmID string, sign func(message []byte, h crypto.Hash) ([]byte, error)) (string, error) {
+	if opt == nil || opt.Expires.IsZero() {
+		return "", fmt.Errorf("%w: expire time is required", ErrSignerPolicy)
+	}
+	h, ok := s.algorithms[algorithmID]
+	if !ok {
+		return "", fmt.Errorf("%w: algorithm ID %q isn't in algorithmIdMap", ErrSignerPolicy, algorithmID)
+	}
+	u, e := url.Parse(rawURL)
+	if e != nil {
+		return "", e
+	}
+	p := s.params
+	params := withoutParams(parseRawQuery(u.RawQuery), p.expire, p.keyID, p.algorithmID, p.digest)
+	params = append(params, newQueryParam(p.expire, strconv.FormatInt(opt.Expires.Unix(), 10)), newQueryParam(p.keyID, keyID), newQueryParam(p.algorithmID, algorithmID))
+	sig, e := sign([]byte(tluMessage(u, params)), h)
+	if e != nil {
+		return "", e
+	}
+	u.RawQuery = joinRawQuery(append(params, newQueryParam(p.digest, hex.EncodeToString(sig))))
+	return u.String(), nil
+}
+
+// verify check parameters, expiry and digest, verify is called with key ID from URL
+func (s *tluSigning) verify(rawURL string, req *VerifyRequest, verify func(keyID string, message []byte, h crypto.Hash, sig []byte) error) error {
+	u, e := url.Parse(rawURL)
+	if e != nil {
+		return e
+	}
+	p := s.params
+	params := parseRawQuery(u.RawQuery)
+	i, d := findParam(params, p.digest)
+	if d == nil {
+		return fmt.Errorf("%w: %s is missing", ErrSignatureInvalid, p.digest)
+	}
+	before := params[:i]
+	values := map[string]string{}
+	for _, n := range []string{p.expire, p.keyID, p.algorithmID} {
+		_, q := findParam(before, n)
+		if q == nil {
+			return fmt.Errorf("%w: %s is missing before %s", ErrSignatureInvalid, n, p.digest)
+		}
+		values[n] = q.value
+	}
+	h, ok := s.algorithms[values[p.algorithmID]]
+	if !ok {
+		return fmt.Errorf("%w: unknown algorithm ID %q", ErrSignatureInvalid, values[p.algorithmID])
+	}
+	sig, e := hex.DecodeString(d.value)
+	if e != nil {
+		return fmt.Errorf("%w: %s isn't hex", ErrSignatureInvalid, p.digest)
+	}
+	if e := verify(values[p.keyID], []byte(tluMessage(u, before)), h, sig); e != nil {
+		return e
+	}
+	exp, e := strconv.ParseInt(values[p.expire], 10, 64)
+	if e != nil {
+		return fmt.Errorf("%w: invalid %s %q", ErrSignatureInvalid, p.expire, values[p.expire])
+	}
+	if req.now().Unix() > exp {
+		return fmt.Errorf("%w: at %s", ErrSignatureExpired, time.Unix(exp, 0).UTC().Format(time.RFC3339))
+	}
+	return nil
+}
+
+// TLUSigner sign URLs validated by authUrlSignHmacTlu
+//
+// Expire, key ID and algorithm ID parameters (P1, P2, P3 by default) are appended to URL, digest (P4) is
+// hex HMAC of protocol://host/path?query before digest, keyed by symmetricKeyIdMap[key ID]
+// using algorithmIdMap[algorithm ID]. Rotate keys by adding a new ID to symmetricKeyIdMap and KeyID.
+type TLUSigner struct {
+	Policy *AuthURLSignHmacTlu
+	// KeyID and AlgorithmID used by Sign, default first ID of the maps
+	KeyID       string
+	AlgorithmID string
+
+	*tluSigning
+	keys map[string][]byte
+}
+
+// NewTLUSigner create signer of authUrlSignHmacTlu policy, algorithmIdMap and symmetricKeyIdMap are required
+func NewTLUSigner(p *AuthURLSignHmacTlu) (*TLUSigner, error) {
+	if p == nil {
+		return nil, fmt.Errorf("%w: authUrlSignHmacTlu is nil", ErrSignerPolicy)
+	}
+	ts, e := newTLUSigning("authUrlSignHmacTlu", p.AlgorithmIDMap, tluParams{p.ExpireParameterName, p.KeyIDParameterName, p.AlgorithmIDParameterName, p.DigestParameterName})
+	if e != nil {
+		return nil, e
+	}
+	s := &TLUSigner{Policy: p, AlgorithmID: ts.firstAlgorithm, tluSigning: ts, keys: map[string][]byte{}}
+	keys, e := ParseHashMap(p.SymmetricKeyIDMap)
+	if e != nil {
+		return nil, fmt.Errorf("%w: authUrlSignHmacTlu symmetricKeyIdMap, %s", ErrSignerPolicy, e.Error())
+	}
+	for _, k := range keys {
+		if s.KeyID == "" {
+			s.KeyID = k.Key
+		}
+		// keys are stored HTML escaped, such like &amp; for &
+		s.keys[k.Key] = []byte(html.UnescapeString(k.Value))
+	}
+	if len(s.keys) == 0 {
+		return nil, fmt.Errorf("%w: authUrlSignHmacTlu symmetricKeyIdMap is required", ErrSignerPolicy)
+	}
+	return s, nil
+}
+
+func tluHMAC(key []byte, message []byte, h crypto.Hash) []byte {
+	m := hmac.New(h.New, key)
+	m.Write(message)
+	return m.Sum(nil)
+}
+
+// Sign add TLU parameters to URL, Expires is required
+func (s *TLUSigner) Sign(rawURL string, opt *SignOptions) (string, error) {
+	key, ok := s.keys[s.KeyID]
+	if !ok {
+		return "", fmt.Errorf("%w: key ID %q isn't in symmetricKeyIdMap", ErrSignerPolicy, s.KeyID)
+	}
+	return s.sign(rawURL, opt, s.KeyID, s.AlgorithmID, func(message []byte, h crypto.Hash) ([]byte, error) {
+		return tluHMAC(key, message, h), nil
+	})
+}
+
+// Verify check URL the way edge does, any key of symmetricKeyIdMap is accepted by its ID
+func (s *TLUSigner) Verify(rawURL string, req *VerifyRequest) error {
+	return s.verify(rawURL, req, func(keyID string, message []byte, h crypto.Hash, sig []byte) error {
+		key, ok := s.keys[keyID]
+		if !ok {
+			return fmt.Errorf("%w: unknown key ID %q", ErrSignatureInvalid, keyID)
+		}
+		if !hmac.Equal(sig, tluHMAC(key, message, h)) {
+			return fmt.Errorf("%w: digest doesn't match", ErrSignatureInvalid)
+		}
+		return nil
+	})
+}
+
+// AsymmetricTLUSigner sign URLs validated by authUrlAsymmetricSignTlu
+//
+// Same as TLUSigner but digest is hex RSA PKCS#1 v1.5 or ECDSA ASN.1 signature made by private key,
+// verified with publicKeyIdMap[key ID]. algorithmIdMap names the digest, such like rsasha256 or hmacsha256.
+type AsymmetricTLUSigner struct {
+	Policy *AuthURLAsymmetricSignTlu
+	// KeyID and AlgorithmID used by Sign, default first ID of the maps
+	KeyID       string
+	AlgorithmID string
+	// PrivateKey used by Sign, nil for verify only signer
+	PrivateKey crypto.Signer
+
+	*tluSigning
+	keys map[string]crypto.PublicKey
+}
+
+// NewAsymmetricTLUSigner create signer of authUrlAsymmetricSignTlu policy, key may be nil to only verify
+func NewAsymmetricTLUSigner(p *AuthURLAsymmetricSignTlu, key crypto.Signer) (*AsymmetricTLUSigner, error) {
+	if p == nil {
+		return nil, fmt.Errorf("%w: authUrlAsymmetricSignTlu is nil", ErrSignerPolicy)
+	}
+	ts, e := newTLUSigning("authUrlAsymmetricSignTlu", p.AlgorithmIDMap, tluParams{p.ExpireParameterName, p.KeyIDParameterName, p.AlgorithmIDParameterName, p.DigestParameterName})
+	if e != nil {
+		return nil, e
+	}
+	s := &AsymmetricTLUSigner{Policy: p, AlgorithmID: ts.firstAlgorithm, PrivateKey: key, tluSigning: ts, keys: map[string]crypto.PublicKey{}}
+	keys, e := ParseHashMap(p.PublicKeyIDMap)
+	if e != nil {
+		return nil, fmt.Errorf("%w: authUrlAsymmetricSignTlu publicKeyIdMap, %s", ErrSignerPolicy, e.Error())
+	}
+	for _, k := range keys {
+		pub, e := ParseTLUPublicKey(k.Value)
+		if e != nil {
+			return nil, fmt.Errorf("%w: authUrlAsymmetricSignTlu publicKeyIdMap %s, %s", ErrSignerPolicy, k.Key, e.Error())
+		}
+		if s.KeyID == "" {
+			s.KeyID = k.Key
+		}
+		s.keys[k.Key] = pub
+	}
+	if len(s.keys) == 0 {
+		return nil, fmt.Errorf("%w: authUrlAsymmetricSignTlu publicKeyIdMap is required", ErrSignerPolicy)
+	}
+	return s, nil
+}
+
+// Sign add TLU parameters to URL, Expires is required, private key must match publicKeyIdMap[KeyID]
+func (s *AsymmetricTLUSigner) Sign(rawURL string, opt *SignOptions) (string, error) {
+	if s.PrivateKey == nil {
+		return "", fmt.Errorf("%w: private key is required", ErrSignerPolicy)
+	}
+	pub, ok := s.keys[s.KeyID]
+	if !ok {
+		return "", fmt.Errorf("%w: key ID %q isn't in publicKeyIdMap", ErrSignerPolicy, s.KeyID)
+	}
+	if k, ok := s.PrivateKey.Public().(interface{ Equal(crypto.PublicKey) bool }); ok && !k.Equal(pub) {
+		return "", fmt.Errorf("%w: private key doesn't match public key %s", ErrSignerPolicy, s.KeyID)
+	}
+	return s.sign(rawURL, opt, s.KeyID, s.AlgorithmID, func(message []byte, h crypto.Hash) ([]byte, error) {
+		d := h.New()
+		d.Write(message)
+		return s.PrivateKey.Sign(rand.Reader, d.Sum(nil), h)
+	})
+}
+
+// Verify check URL the way edge does with public key of key ID in URL
+func (s *AsymmetricTLUSigner) Verify(rawURL string, req *VerifyRequest) error {
+	return s.verify(rawURL, req, func(keyID string, message []byte, h crypto.Hash, sig []byte) error {
+		pub, ok := s.keys[keyID]
+		if !ok {
+			return fmt.Errorf("%w: unknown key ID %q", ErrSignatureInvalid, keyID)
+		}
+		d := h.New()
+		d.Write(message)
+		sum := d.Sum(nil)
+		switch k := pub.(type) {
+		case *rsa.PublicKey:
+			if rsa.VerifyPKCS1v15(k, h, sum, sig) != nil {
+				return fmt.Errorf("%w: signature doesn't match public key %s", ErrSignatureInvalid, keyID)
+			}
+		case *ecdsa.PublicKey:
+			if !ecdsa.VerifyASN1(k, sum, sig) {
+				return fmt.Errorf("%w: signature doesn't match public key %s", ErrSignatureInvalid, keyID)
+			}
+		default:
+			return fmt.Errorf("%w: unsupported public key %T", ErrSignatureInvalid, pub)
+		}
+		return nil
+	})
+}
+
+// decodeBase64 accept standard and URL encoding, with or without padding
+func decodeBase64(s string) ([]byte, error) {
+	s = strings.TrimRight(strings.TrimSpace(s), "=")
+	if strings.ContainsAny(s, "-_") {
+		return base64.RawURLEncoding.DecodeString(s)
+	}
+	return base64.RawStdEncoding.DecodeString(s)
+}
+
+// ParseTLUPublicKey parse value of publicKeyIdMap, "modulus: base64|exponent: base64" for RSA,
+// or PEM / base64 DER of PKIX public key for RSA and ECDSA, the latter is understood by this library only
+func ParseTLUPublicKey(s string) (crypto.PublicKey, error) {
+	s = strings.TrimSpace(s)
+	if strings.Contains(strings.ToLower(s), "modulus") {
+		var n, exp []byte
+		for _, part := range strings.Split(s, "|") {
+			kv := strings.SplitN(part, ":", 2)
+			if len(kv) != 2 {
+				return nil, fmt.Errorf("invalid key part %q", part)
+			}
+			b, e := decodeBase64(kv[1])
+			if e != nil {
+				return nil, fmt.Errorf("invalid %s, %s", strings.TrimSpace(kv[0]), e.Error())
+			}
+			switch strings.ToLower(strings.TrimSpace(kv[0])) {
+			case "modulus":
+				n = b
+			case "exponent":
+				exp = b
+			}
+		}
+		if len(n) == 0 || len(exp) == 0 {
+			return nil, errors.New("modulus and exponent are required")
+		}
+		e := new(big.Int).SetBytes(exp)
+		if !e.IsInt64() || e.Int64() > 1<<31-1 {
+			return nil, errors.New("exponent is too large")
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(e.Int64())}, nil
+	}
+	der := []byte{}
+	if b, _ := pem.Decode([]byte(s)); b != nil {
+		der = b.Bytes
+	} else {
+		var e error
+		if der, e = decodeBase64(s); e != nil {
+			return nil, e
+		}
+	}
+	pub, e := x509.ParsePKIXPublicKey(der)
+	if e != nil {
+		return nil, e
+	}
+	switch pub.(type) {
+	case *rsa.PublicKey, *ecdsa.PublicKey:
+		return pub, nil
+	}
+	return nil, fmt.Errorf("unsupported public key %T", pub)
+}
+
+// FormatTLUPublicKey value for publicKeyIdMap, modulus and exponent format for RSA.
+// ECDSA key is formatted as base64 PKIX DER for ParseTLUPublicKey and tests only, edge doesn't accept it
+func FormatTLUPublicKey(pub crypto.PublicKey) (string, error) {
+	if k, ok := pub.(*rsa.PublicKey); ok {
+		return "modulus: " + base64.StdEncoding.EncodeToString(k.N.Bytes()) + "|exponent: " + base64.StdEncoding.EncodeToString(big.NewInt(int64(k.E)).Bytes()), nil
+	}
+	der, e := x509.MarshalPKIXPublicKey(pub)
+	if e != nil {
+		return "", e
+	}
+	return base64.StdEncoding.EncodeToString(der), nil
+}
+
+// ParsePrivateKeyPEM parse PKCS#1, PKCS#8 or EC private key in PEM
+func ParsePrivateKeyPEM(b []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(b)
+	if block == nil {
+		return nil, errors.New("no PEM block found")
+	}
+	if k, e := x509.ParsePKCS1PrivateKey(block.Bytes); e == nil {
+		return k, nil
+	}
+	if k, e := x509.ParseECPrivateKey(block.Bytes); e == nil {
+		return k, nil
+	}
+	k, e := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if e != nil {
+		return nil, e
+	}
+	s, ok := k.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("unsupported private key %T", k)
+	}
+	return s, nil
+}